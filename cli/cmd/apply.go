@@ -0,0 +1,82 @@
+// Copyright © 2017 NAME HERE <EMAIL ADDRESS>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"os"
+
+	"github.com/ricardo-ch/go-kafka-connect/v3/lib/connectors"
+	"github.com/spf13/cobra"
+)
+
+var applyCmd = &cobra.Command{
+	Use:   "apply",
+	Short: "Execute a plan",
+	Long: `Execute a plan saved by plan, or plan and execute it at once when given configs.
+	Nothing is done if a connector of the plan was changed since it was made.`,
+	RunE: RunEApply,
+}
+
+func RunEApply(cmd *cobra.Command, args []string) error {
+	client, err := getClient()
+	if err != nil {
+		return err
+	}
+	if err := setDeployFlags(client); err != nil {
+		return err
+	}
+
+	var plan connectors.Plan
+	if cmd.Flag("plan").Changed {
+		plan, err = loadPlan(planFile)
+	} else {
+		var configs []connectors.CreateConnectorRequest
+		configs, err = getCreateCmdConfig(cmd)
+		if err != nil {
+			return err
+		}
+		plan, err = client.Plan(configs)
+		if err == nil {
+			printPlan(os.Stdout, plan)
+		}
+	}
+	if err != nil {
+		return err
+	}
+
+	results, err := client.Apply(plan)
+	// nothing was done if the plan could not be applied
+	if len(results) == 0 {
+		return err
+	}
+	if printErr := printDeployResults(os.Stdout, results); printErr != nil && err == nil {
+		err = printErr
+	}
+	return err
+}
+
+func init() {
+	RootCmd.AddCommand(applyCmd)
+
+	applyCmd.PersistentFlags().StringVarP(&planFile, "plan", "f", "", "path to a plan saved by plan")
+	applyCmd.MarkFlagFilename("plan")
+	applyCmd.PersistentFlags().StringVarP(&filePath, "path", "p", "", "path to the config file or folder, to plan and apply at once")
+	applyCmd.MarkFlagFilename("path")
+	applyCmd.PersistentFlags().StringVarP(&configString, "string", "s", "", "JSON configuration string, to plan and apply at once")
+	applyCmd.PersistentFlags().IntVarP(&parallel, "parallel", "r", 3, "limit of parallel call to kafka-connect")
+	applyCmd.PersistentFlags().DurationVar(&settleWindow, "settle-window", 0, "watch connectors for this long once deployed, and roll them back if they or their tasks fail")
+	applyCmd.PersistentFlags().StringVar(&deployStrategy, "strategy", string(connectors.StrategyInPlace), "how existing connectors are updated: in-place, pause-update-resume or recreate")
+	applyCmd.PersistentFlags().BoolVar(&resumePaused, "resume-paused", false, "resume connectors which were paused or stopped before the deploy, they stay so otherwise")
+}
@@ -17,7 +17,6 @@ package cmd
 import (
 	"encoding/json"
 	"io/ioutil"
-	"log"
 	"os"
 	"path"
 	"strings"
@@ -44,8 +43,12 @@ func RunECreate(cmd *cobra.Command, args []string) error {
 
 	//TODO was not expecting I would have to update CreateConnector when adding multiple file deployment feature
 	// will have to add properly later
+	client, err := getClient()
+	if err != nil {
+		return err
+	}
 	for _, config := range configs {
-		resp, err := getClient().CreateConnector(config, sync)
+		resp, err := client.CreateConnector(config, sync)
 		printResponse(resp)
 		if err != nil {
 			return err
@@ -97,7 +100,7 @@ func getConfigFromFolder(folderPath string) ([]connectors.CreateConnectorRequest
 	for _, fileInfo := range configFiles {
 		// Do not evaluate folders
 		if fileInfo.IsDir() {
-			log.Printf("found unexpected subfolder in folder: %s. This command will not search through it.", filePath)
+			getLogger().Warn("found unexpected subfolder, it is not searched", "folder", folderPath, "subfolder", fileInfo.Name())
 			continue
 		}
 		config, err := getConfigFromFile(path.Join(folderPath, fileInfo.Name()))
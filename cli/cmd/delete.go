@@ -32,7 +32,11 @@ func RunEDelete(cmd *cobra.Command, args []string) error {
 		Name: connector,
 	}
 
-	resp, err := getClient().DeleteConnector(req, sync)
+	client, err := getClient()
+	if err != nil {
+		return err
+	}
+	resp, err := client.DeleteConnector(req, sync)
 	if err != nil {
 		return err
 	}
@@ -15,6 +15,16 @@
 package cmd
 
 import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/ricardo-ch/go-kafka-connect/v3/lib/connectors"
 	"github.com/spf13/cobra"
 )
 
@@ -23,7 +33,9 @@ var deployCmd = &cobra.Command{
 	Use:   "deploy",
 	Short: "Deploy a new connector",
 	Long: `Deploy a new connector or replace the old version if it alrerady exists.
-	This command is executes all its steps synchronously.`,
+	This command is executes all its steps synchronously, then prints what was done to each connector.
+	With --prune, connectors selected by --owner-prefix or --owner-key which are not part of the configs are deleted,
+	once confirmed.`,
 	RunE: RunEDeploy,
 }
 
@@ -33,10 +45,99 @@ func RunEDeploy(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	client := getClient()
-	client.SetParallelism(parallel)
+	client, err := getClient()
+	if err != nil {
+		return err
+	}
+	if err := setDeployFlags(client); err != nil {
+		return err
+	}
+
+	var results []connectors.DeployResult
+	if prune {
+		results, err = deployAndPrune(client, configs, os.Stdin, os.Stdout)
+		if len(results) == 0 {
+			return err
+		}
+	} else {
+		results, err = client.DeployMultipleConnectorWithResult(configs)
+	}
+	if printErr := printDeployResults(os.Stdout, results); printErr != nil && err == nil {
+		err = printErr
+	}
+	return err
+}
 
-	return client.DeployMultipleConnector(configs)
+// deployAndPrune deploys configs and deletes the connectors selected by the owner flags which are not part of them,
+// once the user confirmed deletions
+func deployAndPrune(client connectors.HighLevelClient, configs []connectors.CreateConnectorRequest, in io.Reader, out io.Writer) ([]connectors.DeployResult, error) {
+	plan, err := client.PlanReconcile(connectors.ReconcileRequest{
+		Connectors: configs,
+		Owner:      connectors.OwnershipSelector{NamePrefix: ownerPrefix, MarkerKey: ownerKey, MarkerValue: ownerValue},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	printPlan(out, plan)
+	if deleted := plan.Count(connectors.DeployDeleted); deleted > 0 && !assumeYes {
+		if !confirm(in, out, fmt.Sprintf("Delete %d connectors?", deleted)) {
+			return nil, errors.New("deployment aborted")
+		}
+	}
+	return client.Apply(plan)
+}
+
+// confirm asks a yes/no question, anything but yes is a no
+func confirm(in io.Reader, out io.Writer, question string) bool {
+	fmt.Fprintf(out, "%s [y/N] ", question)
+	answer, _ := bufio.NewReader(in).ReadString('\n')
+	answer = strings.ToLower(strings.TrimSpace(answer))
+	return answer == "y" || answer == "yes"
+}
+
+// printDeployResults prints a summary table of what was done to each connector
+func printDeployResults(out io.Writer, results []connectors.DeployResult) error {
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "NAME\tACTION\tCHANGES\tSTATE\tTASKS\tDURATION\tERROR")
+	for _, result := range results {
+		action, changes, state, errMessage := "-", "-", "-", ""
+		if result.Action != "" {
+			action = string(result.Action)
+			changes = fmt.Sprintf("%d", len(result.Diff))
+		}
+		if result.RolledBack {
+			action += " (rolled back)"
+		}
+		if result.ConnectorState != "" {
+			state = result.ConnectorState
+		}
+		running := 0
+		for _, task := range result.Tasks {
+			if task.State == connectors.StateRunning {
+				running++
+			}
+		}
+		if result.Err != nil {
+			// keep the table on one line per connector
+			errMessage = strings.ReplaceAll(result.Err.Error(), "\n", " ")
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%d/%d\t%s\t%s\n", result.Name, action, changes, state,
+			running, len(result.Tasks), result.Duration.Round(time.Millisecond), errMessage)
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	// traces are only sent for failed tasks, their first line tells what went wrong
+	for _, result := range results {
+		for _, task := range result.Tasks {
+			if task.Trace != "" {
+				fmt.Fprintf(out, "%s task %d: %s\n", result.Name, task.ID, strings.SplitN(task.Trace, "\n", 2)[0])
+			}
+		}
+	}
+	return nil
 }
 
 func init() {
@@ -46,4 +147,12 @@ func init() {
 	deployCmd.MarkFlagFilename("path")
 	deployCmd.PersistentFlags().StringVarP(&configString, "string", "s", "", "JSON configuration string")
 	deployCmd.PersistentFlags().IntVarP(&parallel, "parallel", "r", 3, "limit of parallel call to kafka-connect")
+	deployCmd.PersistentFlags().DurationVar(&settleWindow, "settle-window", 0, "watch connectors for this long once deployed, and roll them back if they or their tasks fail")
+	deployCmd.PersistentFlags().StringVar(&deployStrategy, "strategy", string(connectors.StrategyInPlace), "how existing connectors are updated: in-place, pause-update-resume or recreate")
+	deployCmd.PersistentFlags().BoolVar(&resumePaused, "resume-paused", false, "resume connectors which were paused or stopped before the deploy, they stay so otherwise")
+	deployCmd.PersistentFlags().BoolVar(&prune, "prune", false, "delete connectors selected by the owner flags which are not part of the configs")
+	deployCmd.PersistentFlags().StringVar(&ownerPrefix, "owner-prefix", "", "with --prune, select connectors which name starts with this prefix")
+	deployCmd.PersistentFlags().StringVar(&ownerKey, "owner-key", "", "with --prune, select connectors which config has this key")
+	deployCmd.PersistentFlags().StringVar(&ownerValue, "owner-value", "", "with --prune, the value --owner-key must have, any if empty")
+	deployCmd.PersistentFlags().BoolVarP(&assumeYes, "yes", "y", false, "with --prune, delete connectors without asking for confirmation")
 }
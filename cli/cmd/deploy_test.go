@@ -0,0 +1,83 @@
+package cmd
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/ricardo-ch/go-kafka-connect/v3/lib/connectors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_printDeployResults(t *testing.T) {
+	out := &bytes.Buffer{}
+	err := printDeployResults(out, []connectors.DeployResult{
+		{
+			Name:           "test1",
+			Action:         connectors.DeployUpdated,
+			Diff:           connectors.ConfigDiff{{Key: "param1", Old: 2, New: 3}},
+			Duration:       1500 * time.Millisecond,
+			ConnectorState: "RUNNING",
+			Tasks:          []connectors.TaskStatus{{ID: 0, State: "RUNNING"}, {ID: 1, State: "FAILED"}},
+		},
+		{Name: "test2", Err: errors.New("random\nerror")},
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "NAME   ACTION   CHANGES  STATE    TASKS  DURATION  ERROR\n"+
+		"test1  updated  1        RUNNING  1/2    1.5s      \n"+
+		"test2  -        -        -        0/0    0s        random error\n", out.String())
+}
+
+func Test_deployAndPrune_Aborted(t *testing.T) {
+	ownerPrefix = "a-"
+	defer func() { ownerPrefix = "" }()
+	plan := connectors.Plan{Actions: []connectors.PlannedAction{{Name: "a-removed", Action: connectors.DeployDeleted}}}
+
+	client := &connectors.MockHighLevelClient{}
+	client.On("PlanReconcile", connectors.ReconcileRequest{Owner: connectors.OwnershipSelector{NamePrefix: "a-"}}).Return(plan, nil)
+
+	out := &bytes.Buffer{}
+	results, err := deployAndPrune(client, nil, strings.NewReader("n\n"), out)
+
+	assert.Error(t, err)
+	assert.Nil(t, results)
+	assert.Contains(t, out.String(), "- a-removed will be deleted\n")
+	assert.Contains(t, out.String(), "Delete 1 connectors? [y/N] ")
+	client.AssertNotCalled(t, "Apply", mock.Anything)
+}
+
+func Test_deployAndPrune_Confirmed(t *testing.T) {
+	ownerPrefix = "a-"
+	defer func() { ownerPrefix = "" }()
+	plan := connectors.Plan{Actions: []connectors.PlannedAction{{Name: "a-removed", Action: connectors.DeployDeleted}}}
+	expected := []connectors.DeployResult{{Name: "a-removed", Action: connectors.DeployDeleted}}
+
+	client := &connectors.MockHighLevelClient{}
+	client.On("PlanReconcile", mock.Anything).Return(plan, nil)
+	client.On("Apply", plan).Return(expected, nil)
+
+	results, err := deployAndPrune(client, nil, strings.NewReader("yes\n"), &bytes.Buffer{})
+
+	assert.NoError(t, err)
+	assert.Equal(t, expected, results)
+}
+
+func Test_printDeployResults_Rolled_Back(t *testing.T) {
+	out := &bytes.Buffer{}
+	err := printDeployResults(out, []connectors.DeployResult{{
+		Name:           "test1",
+		Action:         connectors.DeployUpdated,
+		ConnectorState: "RUNNING",
+		Tasks:          []connectors.TaskStatus{{ID: 0, State: "FAILED", Trace: "ConnectException: boom\n\tat Task.start"}},
+		Err:            errors.New("connector unhealthy after deploy test1: task 0 FAILED, rolled back"),
+		RolledBack:     true,
+	}})
+
+	assert.NoError(t, err)
+	assert.Contains(t, out.String(), "test1  updated (rolled back)  ")
+	assert.Contains(t, out.String(), "\ntest1 task 0: ConnectException: boom\n")
+}
@@ -62,7 +62,10 @@ func validateArgs() error {
 
 func getConnector() error {
 
-	client := getClient()
+	client, err := getClient()
+	if err != nil {
+		return err
+	}
 	req := connectors.ConnectorRequest{
 		Name: connector,
 	}
@@ -77,7 +80,10 @@ func getConnector() error {
 
 func getConfig() error {
 
-	client := getClient()
+	client, err := getClient()
+	if err != nil {
+		return err
+	}
 	req := connectors.ConnectorRequest{
 		Name: connector,
 	}
@@ -92,7 +98,10 @@ func getConfig() error {
 
 func getStatus() error {
 
-	client := getClient()
+	client, err := getClient()
+	if err != nil {
+		return err
+	}
 	req := connectors.ConnectorRequest{
 		Name: connector,
 	}
@@ -107,7 +116,10 @@ func getStatus() error {
 
 func getTasks() error {
 
-	client := getClient()
+	client, err := getClient()
+	if err != nil {
+		return err
+	}
 	req := connectors.ConnectorRequest{
 		Name: connector,
 	}
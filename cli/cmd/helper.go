@@ -1,11 +1,14 @@
 package cmd
 
 import (
-	"crypto/tls"
+	"context"
 	"encoding/json"
 	"fmt"
 	"log"
+	"os"
+	"strings"
 
+	"github.com/pkg/errors"
 	"github.com/ricardo-ch/go-kafka-connect/v3/lib/connectors"
 )
 
@@ -18,24 +21,58 @@ func printResponse(response interface{}) error {
 	return nil
 }
 
-func getClient() connectors.HighLevelClient {
-	client := connectors.NewClient(url)
+// getLogger returns the logger of commands, which logs every request with --verbose
+func getLogger() connectors.Logger {
+	level := connectors.LogLevelInfo
 	if verbose {
-		client.SetDebug()
+		level = connectors.LogLevelDebug
 	}
+	return connectors.NewStdLogger(log.New(os.Stderr, "", log.LstdFlags), level)
+}
+
+func getClient() (connectors.HighLevelClient, error) {
+	var opts []connectors.Option
+	if caCert != "" {
+		opts = append(opts, connectors.WithCACertFile(caCert))
+	}
+	if len(SSLClientCertificate) > 0 && len(SSLClientPrivateKey) > 0 {
+		opts = append(opts, connectors.WithClientCertificateFiles(SSLClientCertificate, SSLClientPrivateKey))
+	}
+	opts = append(opts, connectors.WithRateLimit(rateLimit, rateBurst), connectors.WithMaxInFlight(maxInFlight))
+
+	var client connectors.HighLevelClient
+	var multiClient connectors.MultiWorkerClient
+	var err error
+	if urls := strings.Split(url, ","); len(urls) > 1 {
+		// commands are short-lived, workers are checked once the client is configured rather than in the background
+		multiClient, err = connectors.NewMultiWorkerClient(urls, append(opts, connectors.WithWorkerCheckInterval(0))...)
+		client = multiClient
+	} else {
+		client, err = connectors.NewClientWithOptions(url, opts...)
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "client")
+	}
+
+	client.SetLogger(getLogger())
 	if SSLInsecure {
 		client.SetInsecureSSL()
 	}
 	if basicAuthUsername != "" && basicAuthPassword != "" {
 		client.SetBasicAuth(basicAuthUsername, basicAuthPassword)
 	}
-	if len(SSLClientCertificate) > 0 && len(SSLClientPrivateKey) > 0 {
-		cert, err := tls.LoadX509KeyPair(SSLClientCertificate, SSLClientPrivateKey)
-		if err != nil {
-			log.Fatalf("client: loadkeys: %s", err)
-		} else {
-			client.SetClientCertificates(cert)
-		}
+	switch {
+	case bearerToken != "":
+		client.SetTokenSource(connectors.StaticToken(bearerToken))
+	case bearerTokenFile != "":
+		client.SetTokenSource(connectors.FileToken(bearerTokenFile))
+	case oauthTokenURL != "":
+		client.SetTokenSource(connectors.ClientCredentialsToken(connectors.ClientCredentialsConfig{
+			TokenURL:     oauthTokenURL,
+			ClientID:     oauthClientID,
+			ClientSecret: oauthClientSecret,
+			Scopes:       oauthScopes,
+		}))
 	}
 	if len(extraHeaders.Headers) > 0 {
 		for _, header := range extraHeaders.Headers {
@@ -43,5 +80,27 @@ func getClient() connectors.HighLevelClient {
 		}
 	}
 
-	return client
+	if multiClient != nil {
+		for workerURL, err := range multiClient.CheckWorkers(context.Background()) {
+			if err != nil {
+				getLogger().Warn("worker unhealthy, it is tried last", "worker", workerURL, "error", err)
+			}
+		}
+	}
+	return client, nil
+}
+
+// setDeployFlags configures how deploy and apply update connectors
+func setDeployFlags(client connectors.HighLevelClient) error {
+	strategy := connectors.DeployStrategy(deployStrategy)
+	switch strategy {
+	case connectors.StrategyInPlace, connectors.StrategyPauseUpdateResume, connectors.StrategyRecreate:
+	default:
+		return errors.Errorf("unknown deploy strategy: %v", deployStrategy)
+	}
+
+	client.SetParallelism(parallel)
+	client.SetHealthCheck(connectors.HealthCheckOptions{SettleWindow: settleWindow})
+	client.SetDeployOptions(connectors.DeployOptions{Strategy: strategy, ResumePaused: resumePaused})
+	return nil
 }
@@ -0,0 +1,39 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/ricardo-ch/go-kafka-connect/v3/lib/connectors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_getClient_Invalid_CA_Cert(t *testing.T) {
+	caCert = "missing.pem"
+	defer func() { caCert = "" }()
+
+	client, err := getClient()
+
+	assert.Error(t, err)
+	assert.Nil(t, client)
+}
+
+func Test_setDeployFlags(t *testing.T) {
+	deployStrategy, resumePaused, parallel = "recreate", true, 2
+	defer func() { deployStrategy, resumePaused, parallel = "in-place", false, 3 }()
+
+	client := &connectors.MockHighLevelClient{}
+	client.On("SetParallelism", 2).Return()
+	client.On("SetHealthCheck", mock.Anything).Return()
+	client.On("SetDeployOptions", connectors.DeployOptions{Strategy: connectors.StrategyRecreate, ResumePaused: true}).Return()
+
+	assert.NoError(t, setDeployFlags(client))
+	client.AssertExpectations(t)
+}
+
+func Test_setDeployFlags_Unknown_Strategy(t *testing.T) {
+	deployStrategy = "blue-green"
+	defer func() { deployStrategy = "in-place" }()
+
+	assert.Error(t, setDeployFlags(&connectors.MockHighLevelClient{}))
+}
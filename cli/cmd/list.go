@@ -16,6 +16,11 @@ package cmd
 
 import (
 	"fmt"
+	"os"
+	"sort"
+	"text/tabwriter"
+
+	"github.com/ricardo-ch/go-kafka-connect/v3/lib/connectors"
 	"github.com/spf13/cobra"
 )
 
@@ -26,7 +31,15 @@ var listCmd = &cobra.Command{
 }
 
 func listConnectors(cmd *cobra.Command, args []string) error {
-	resp, err := getClient().GetAll()
+	if status {
+		return listConnectorsWithStatus()
+	}
+
+	client, err := getClient()
+	if err != nil {
+		return err
+	}
+	resp, err := client.GetAll()
 	if err != nil {
 		return err
 	}
@@ -38,7 +51,40 @@ func listConnectors(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+// listConnectorsWithStatus fetches every status in a single call rather than one per connector
+func listConnectorsWithStatus() error {
+	client, err := getClient()
+	if err != nil {
+		return err
+	}
+	resp, err := client.GetAllExpanded(true, false)
+	if err != nil {
+		return err
+	}
+
+	names := make([]string, 0, len(resp.Connectors))
+	for name := range resp.Connectors {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	for _, name := range names {
+		connectorStatus := resp.Connectors[name].Status
+		running := 0
+		for _, task := range connectorStatus.TasksStatus {
+			if task.State == connectors.StateRunning {
+				running++
+			}
+		}
+		fmt.Fprintf(w, "%s\t%s\t%d/%d tasks running\n", name, connectorStatus.ConnectorStatus["state"], running, len(connectorStatus.TasksStatus))
+	}
+
+	return w.Flush()
+}
+
 func init() {
 	RootCmd.AddCommand(listCmd)
 
+	listCmd.PersistentFlags().BoolVarP(&status, "status", "s", false, "also print connectors and tasks state")
 }
@@ -0,0 +1,150 @@
+// Copyright © 2017 NAME HERE <EMAIL ADDRESS>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/ricardo-ch/go-kafka-connect/v3/lib/connectors"
+	"github.com/spf13/cobra"
+)
+
+type loggersCmdConfig struct {
+	logger    string
+	level     string
+	scope     string
+	temporary time.Duration
+}
+
+var loggers loggersCmdConfig
+
+// loggersCmd represents the loggers command group
+var loggersCmd = &cobra.Command{
+	Use:   "loggers",
+	Short: "Manage log levels of kafka-connect workers",
+}
+
+var loggersListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List log levels of every configured logger",
+	RunE:  RunELoggersList,
+}
+
+var loggersGetCmd = &cobra.Command{
+	Use:   "get",
+	Short: "Get log level of a logger",
+	RunE:  RunELoggersGet,
+}
+
+var loggersSetCmd = &cobra.Command{
+	Use:   "set",
+	Short: "Set log level of a logger",
+	Long: `Set log level of a logger and its children.
+	With --temporary, the command waits for the given duration then restores the previous level.
+	Interrupting the command restores the previous level right away.`,
+	RunE: RunELoggersSet,
+}
+
+//RunELoggersList ...
+func RunELoggersList(cmd *cobra.Command, args []string) error {
+	client, err := getClient()
+	if err != nil {
+		return err
+	}
+	resp, err := client.GetLoggers()
+	if err != nil {
+		return err
+	}
+	return printResponse(resp)
+}
+
+//RunELoggersGet ...
+func RunELoggersGet(cmd *cobra.Command, args []string) error {
+	client, err := getClient()
+	if err != nil {
+		return err
+	}
+	resp, err := client.GetLogger(connectors.LoggerRequest{Name: loggers.logger})
+	if err != nil {
+		return err
+	}
+	return printResponse(resp)
+}
+
+//RunELoggersSet ...
+func RunELoggersSet(cmd *cobra.Command, args []string) error {
+	req := connectors.SetLogLevelRequest{
+		LoggerRequest: connectors.LoggerRequest{Name: loggers.logger},
+		Level:         loggers.level,
+		Scope:         loggers.scope,
+	}
+
+	client, err := getClient()
+	if err != nil {
+		return err
+	}
+	if loggers.temporary <= 0 {
+		resp, err := client.SetLogLevel(req)
+		if err != nil {
+			return err
+		}
+		return printResponse(resp)
+	}
+
+	temporary, err := client.SetLogLevelTemporarily(req, loggers.temporary)
+	if err != nil {
+		return err
+	}
+	if err := printResponse(temporary.SetLogLevelResponse); err != nil {
+		return err
+	}
+	fmt.Printf("level will be restored to %s in %v\n", temporary.PreviousLevel, loggers.temporary)
+
+	interrupt := make(chan os.Signal, 1)
+	signal.Notify(interrupt, os.Interrupt, syscall.SIGTERM)
+	defer signal.Stop(interrupt)
+
+	select {
+	case err = <-temporary.Done():
+	case <-interrupt:
+		err = temporary.Restore()
+	}
+	if err != nil {
+		return err
+	}
+	fmt.Printf("level restored to %s\n", temporary.PreviousLevel)
+	return nil
+}
+
+func init() {
+	RootCmd.AddCommand(loggersCmd)
+	loggersCmd.AddCommand(loggersListCmd)
+	loggersCmd.AddCommand(loggersGetCmd)
+	loggersCmd.AddCommand(loggersSetCmd)
+
+	loggersGetCmd.PersistentFlags().StringVarP(&loggers.logger, "logger", "l", "", "name of the target logger")
+	loggersGetCmd.MarkPersistentFlagRequired("logger")
+
+	loggersSetCmd.PersistentFlags().StringVarP(&loggers.logger, "logger", "l", "", "name of the target logger")
+	loggersSetCmd.MarkPersistentFlagRequired("logger")
+	loggersSetCmd.PersistentFlags().StringVarP(&loggers.level, "level", "L", "", "new log level, e.g. DEBUG")
+	loggersSetCmd.MarkPersistentFlagRequired("level")
+	loggersSetCmd.PersistentFlags().StringVarP(&loggers.scope, "scope", "S", connectors.LogScopeWorker, "scope of the change: worker or cluster")
+	loggersSetCmd.PersistentFlags().DurationVarP(&loggers.temporary, "temporary", "t", 0, "restore the previous level after this duration, e.g. 10m")
+}
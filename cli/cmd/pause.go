@@ -31,7 +31,11 @@ func RunEPause(cmd *cobra.Command, args []string) error {
 	req := connectors.ConnectorRequest{
 		Name: connector,
 	}
-	resp, err := getClient().PauseConnector(req, sync)
+	client, err := getClient()
+	if err != nil {
+		return err
+	}
+	resp, err := client.PauseConnector(req, sync)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,107 @@
+// Copyright © 2017 NAME HERE <EMAIL ADDRESS>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+
+	"github.com/pkg/errors"
+	"github.com/ricardo-ch/go-kafka-connect/v3/lib/connectors"
+	"github.com/spf13/cobra"
+)
+
+var planCmd = &cobra.Command{
+	Use:   "plan",
+	Short: "Show what deploy would change",
+	Long: `Compare connector configs with the ones deployed and show which connectors would be created or updated,
+	with the changes of their config. Nothing is changed. The plan can be saved to be executed by apply.`,
+	RunE: RunEPlan,
+}
+
+func RunEPlan(cmd *cobra.Command, args []string) error {
+	configs, err := getCreateCmdConfig(cmd)
+	if err != nil {
+		return err
+	}
+
+	client, err := getClient()
+	if err != nil {
+		return err
+	}
+	plan, err := client.Plan(configs)
+	if err != nil {
+		return err
+	}
+
+	printPlan(os.Stdout, plan)
+	if planFile != "" {
+		return savePlan(planFile, plan)
+	}
+	return nil
+}
+
+// printPlan prints the changes of every connector, values of secrets are redacted
+func printPlan(out io.Writer, plan connectors.Plan) {
+	for _, planned := range plan.Actions {
+		switch planned.Action {
+		case connectors.DeployCreated:
+			fmt.Fprintf(out, "+ %s will be created\n", planned.Name)
+		case connectors.DeployUpdated:
+			fmt.Fprintf(out, "~ %s will be updated\n", planned.Name)
+		case connectors.DeployDeleted:
+			fmt.Fprintf(out, "- %s will be deleted\n", planned.Name)
+		default:
+			fmt.Fprintf(out, "  %s is up to date\n", planned.Name)
+		}
+		for _, change := range planned.Diff {
+			fmt.Fprintf(out, "    %s\n", change)
+		}
+	}
+	fmt.Fprintf(out, "Plan: %d to create, %d to update, %d to delete, %d unchanged\n",
+		plan.Count(connectors.DeployCreated), plan.Count(connectors.DeployUpdated), plan.Count(connectors.DeployDeleted),
+		plan.Count(connectors.DeployUnchanged))
+}
+
+// savePlan writes a plan as json, only the owner can read it as it holds connector configs, secrets included
+func savePlan(filename string, plan connectors.Plan) error {
+	content, err := json.MarshalIndent(plan, "", "  ")
+	if err != nil {
+		return err
+	}
+	return errors.Wrapf(ioutil.WriteFile(filename, content, 0600), "could not save plan to %v", filename)
+}
+
+func loadPlan(filename string) (connectors.Plan, error) {
+	plan := connectors.Plan{}
+	content, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return plan, errors.Wrapf(err, "could not read plan %v", filename)
+	}
+	err = json.Unmarshal(content, &plan)
+	return plan, errors.Wrapf(err, "could not parse plan %v", filename)
+}
+
+func init() {
+	RootCmd.AddCommand(planCmd)
+
+	planCmd.PersistentFlags().StringVarP(&filePath, "path", "p", "", "path to the config file or folder")
+	planCmd.MarkFlagFilename("path")
+	planCmd.PersistentFlags().StringVarP(&configString, "string", "s", "", "JSON configuration string")
+	planCmd.PersistentFlags().StringVarP(&planFile, "out", "o", "", "save the plan to this file, to be executed by apply")
+}
@@ -0,0 +1,66 @@
+package cmd
+
+import (
+	"bytes"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/ricardo-ch/go-kafka-connect/v3/lib/connectors"
+	"github.com/stretchr/testify/assert"
+)
+
+var testPlan = connectors.Plan{Actions: []connectors.PlannedAction{
+	{
+		Name:   "test1",
+		Action: connectors.DeployCreated,
+		Diff:   connectors.ConfigDiff{{Key: "db.password", New: "secret"}, {Key: "param1", New: "2"}},
+		Config: map[string]interface{}{"db.password": "secret", "param1": "2"},
+	},
+	{
+		Name:       "test2",
+		Action:     connectors.DeployUpdated,
+		Diff:       connectors.ConfigDiff{{Key: "param1", Old: "2", New: "3"}},
+		Config:     map[string]interface{}{"param1": "3"},
+		LiveConfig: map[string]interface{}{"name": "test2", "param1": "2"},
+	},
+	{
+		Name:       "test3",
+		Action:     connectors.DeployUnchanged,
+		Diff:       connectors.ConfigDiff{},
+		Config:     map[string]interface{}{"param1": "2"},
+		LiveConfig: map[string]interface{}{"name": "test3", "param1": "2"},
+	},
+}}
+
+func Test_printPlan(t *testing.T) {
+	out := &bytes.Buffer{}
+	printPlan(out, testPlan)
+
+	assert.Equal(t, "+ test1 will be created\n"+
+		"    +db.password=[REDACTED]\n"+
+		"    +param1=2\n"+
+		"~ test2 will be updated\n"+
+		"    ~param1=2->3\n"+
+		"  test3 is up to date\n"+
+		"Plan: 1 to create, 1 to update, 0 to delete, 1 unchanged\n", out.String())
+}
+
+func Test_savePlan_loadPlan(t *testing.T) {
+	filename := path.Join(t.TempDir(), "plan.json")
+
+	assert.NoError(t, savePlan(filename, testPlan))
+	info, err := os.Stat(filename)
+	assert.NoError(t, err)
+	assert.Equal(t, os.FileMode(0600), info.Mode().Perm())
+
+	plan, err := loadPlan(filename)
+	assert.NoError(t, err)
+	assert.Equal(t, testPlan.Actions, plan.Actions)
+}
+
+func Test_loadPlan_Missing_File(t *testing.T) {
+	_, err := loadPlan("missing.json")
+
+	assert.Error(t, err)
+}
@@ -0,0 +1,61 @@
+// Copyright © 2017 NAME HERE <EMAIL ADDRESS>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"github.com/ricardo-ch/go-kafka-connect/v3/lib/connectors"
+	"github.com/spf13/cobra"
+)
+
+var (
+	restartIncludeTasks bool
+	restartOnlyFailed   bool
+)
+
+// restartCmd represents the restart command
+var restartCmd = &cobra.Command{
+	Use:   "restart",
+	Short: "Restart a connector",
+	Long: `Restart a connector, and optionally its tasks.
+	In sync mode, it waits for restarted tasks to be up again and reports those which ended FAILED.`,
+	RunE: RunERestart,
+}
+
+//RunERestart ...
+func RunERestart(cmd *cobra.Command, args []string) error {
+	req := connectors.RestartConnectorRequest{
+		ConnectorRequest: connectors.ConnectorRequest{Name: connector},
+		IncludeTasks:     restartIncludeTasks,
+		OnlyFailed:       restartOnlyFailed,
+	}
+	client, err := getClient()
+	if err != nil {
+		return err
+	}
+	resp, err := client.RestartConnectorWithOptions(req, sync)
+	if err != nil {
+		return err
+	}
+	return printResponse(resp)
+}
+
+func init() {
+	RootCmd.AddCommand(restartCmd)
+
+	restartCmd.PersistentFlags().BoolVarP(&sync, "sync", "y", false, "execute synchronously")
+	restartCmd.PersistentFlags().StringVarP(&connector, "connector", "n", "", "name of the target connector")
+	restartCmd.PersistentFlags().BoolVarP(&restartIncludeTasks, "include-tasks", "t", false, "restart the connector's tasks as well")
+	restartCmd.PersistentFlags().BoolVarP(&restartOnlyFailed, "only-failed", "f", false, "restart only failed instances")
+}
@@ -31,7 +31,11 @@ func RunEResume(cmd *cobra.Command, args []string) error {
 	req := connectors.ConnectorRequest{
 		Name: connector,
 	}
-	resp, err := getClient().ResumeConnector(req, sync)
+	client, err := getClient()
+	if err != nil {
+		return err
+	}
+	resp, err := client.ResumeConnector(req, sync)
 	if err != nil {
 		return err
 	}
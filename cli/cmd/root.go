@@ -17,6 +17,7 @@ package cmd
 import (
 	"fmt"
 	"os"
+	"time"
 
 	"github.com/spf13/cobra"
 )
@@ -26,6 +27,15 @@ var (
 	connector            string
 	filePath             string
 	configString         string
+	planFile             string
+	prune                bool
+	ownerPrefix          string
+	ownerKey             string
+	ownerValue           string
+	assumeYes            bool
+	settleWindow         time.Duration
+	deployStrategy       string
+	resumePaused         bool
 	sync                 bool
 	status               bool
 	config               bool
@@ -35,9 +45,19 @@ var (
 	parallel             int
 	SSLClientCertificate string
 	SSLClientPrivateKey  string
+	caCert               string
 	basicAuthUsername    string
 	basicAuthPassword    string
+	bearerToken          string
+	bearerTokenFile      string
+	oauthTokenURL        string
+	oauthClientID        string
+	oauthClientSecret    string
+	oauthScopes          []string
 	extraHeaders         HeadersFlag
+	rateLimit            float64
+	rateBurst            int
+	maxInFlight          int
 )
 
 var RootCmd = &cobra.Command{
@@ -58,12 +78,22 @@ func Execute() {
 }
 
 func init() {
-	RootCmd.PersistentFlags().StringVarP(&url, "url", "u", "http://localhost:8083", "kafka connect URL")
-	RootCmd.PersistentFlags().BoolVarP(&verbose, "verbose", "v", false, `/!\ very verbose`)
+	RootCmd.PersistentFlags().StringVarP(&url, "url", "u", "http://localhost:8083", "kafka connect URL, or comma-separated URLs of several workers of the same cluster")
+	RootCmd.PersistentFlags().BoolVarP(&verbose, "verbose", "v", false, `log every request, with secrets redacted`)
 	RootCmd.PersistentFlags().BoolVarP(&SSLInsecure, "insecure-skip-verify", "i", false, `skip SSL/TLS verification`)
 	RootCmd.PersistentFlags().StringVarP(&SSLClientCertificate, "ssl-client-certificate", "C", "", `path to client certificate, must contain PEM encoded data`)
 	RootCmd.PersistentFlags().StringVarP(&SSLClientPrivateKey, "ssl-client-key", "K", "", `path to client private key`)
+	RootCmd.PersistentFlags().StringVar(&caCert, "ca-cert", "", `path to a PEM file of certificate authorities to trust, in addition to the system ones`)
 	RootCmd.PersistentFlags().StringVarP(&basicAuthUsername, "username", "U", "", `HTTP Basic Auth username`)
 	RootCmd.PersistentFlags().StringVarP(&basicAuthPassword, "password", "P", "", `HTTP Basic Auth password`)
+	RootCmd.PersistentFlags().StringVar(&bearerToken, "token", "", `bearer token sent with every request`)
+	RootCmd.PersistentFlags().StringVar(&bearerTokenFile, "token-file", "", `path to a file containing the bearer token, read again when it changes`)
+	RootCmd.PersistentFlags().StringVar(&oauthTokenURL, "oauth-token-url", "", `OAuth2 token endpoint, to get bearer tokens with the client credentials flow`)
+	RootCmd.PersistentFlags().StringVar(&oauthClientID, "oauth-client-id", "", `OAuth2 client id`)
+	RootCmd.PersistentFlags().StringVar(&oauthClientSecret, "oauth-client-secret", "", `OAuth2 client secret`)
+	RootCmd.PersistentFlags().StringSliceVar(&oauthScopes, "oauth-scopes", nil, `OAuth2 scopes, comma-separated`)
+	RootCmd.PersistentFlags().Float64Var(&rateLimit, "rate-limit", 0, `maximum requests per second sent to kafka connect, 0 for no limit`)
+	RootCmd.PersistentFlags().IntVar(&rateBurst, "rate-burst", 1, `requests which can be sent at once with --rate-limit`)
+	RootCmd.PersistentFlags().IntVar(&maxInFlight, "max-in-flight", 0, `maximum requests waiting for an answer at once, 0 for no limit`)
 	RootCmd.PersistentFlags().VarP(&extraHeaders, "header", "H", "extra HTTP headers to attach to REST API requests")
 }
@@ -0,0 +1,50 @@
+// Copyright © 2017 NAME HERE <EMAIL ADDRESS>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"github.com/ricardo-ch/go-kafka-connect/v3/lib/connectors"
+	"github.com/spf13/cobra"
+)
+
+// stopCmd represents the stop command
+var stopCmd = &cobra.Command{
+	Use:   "stop",
+	Short: "Stop a connector",
+	RunE:  RunEStop,
+}
+
+//RunEStop ...
+func RunEStop(cmd *cobra.Command, args []string) error {
+	req := connectors.ConnectorRequest{
+		Name: connector,
+	}
+	client, err := getClient()
+	if err != nil {
+		return err
+	}
+	resp, err := client.StopConnector(req, sync)
+	if err != nil {
+		return err
+	}
+	return printResponse(resp)
+}
+
+func init() {
+	RootCmd.AddCommand(stopCmd)
+
+	stopCmd.PersistentFlags().BoolVarP(&sync, "sync", "y", false, "execute synchronously")
+	stopCmd.PersistentFlags().StringVarP(&connector, "connector", "n", "", "name of the target connector")
+}
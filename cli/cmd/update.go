@@ -50,7 +50,11 @@ func RunEUpdate(cmd *cobra.Command, args []string) error {
 	}
 
 	req.Name = update.connector
-	resp, err := getClient().UpdateConnector(req, sync)
+	client, err := getClient()
+	if err != nil {
+		return err
+	}
+	resp, err := client.UpdateConnector(req, sync)
 	if err != nil {
 		return err
 	}
@@ -5,9 +5,10 @@ go 1.17
 require (
 	bou.ke/monkey v1.0.1
 	github.com/hashicorp/go-multierror v1.0.0
-	github.com/pkg/errors v0.8.1
+	github.com/pkg/errors v0.9.1
 	github.com/spf13/cobra v0.0.1
 	github.com/stretchr/testify v1.2.2
+	golang.org/x/time v0.3.0
 	gopkg.in/jarcoal/httpmock.v1 v1.0.0-20181117152235-275e9df93516
 	gopkg.in/resty.v1 v1.11.0
 )
@@ -19,9 +20,5 @@ require (
 	github.com/pmezard/go-difflib v1.0.0 // indirect
 	github.com/spf13/pflag v1.0.0 // indirect
 	github.com/stretchr/objx v0.1.1 // indirect
-	github.com/vektra/mockery v1.1.2 // indirect
-	golang.org/x/mod v0.2.0 // indirect
 	golang.org/x/net v0.0.0-20200226121028-0de0cce0169b // indirect
-	golang.org/x/tools v0.0.0-20200323144430-8dcfad9e016e // indirect
-	golang.org/x/xerrors v0.0.0-20191204190536-9bdfabe68543 // indirect
 )
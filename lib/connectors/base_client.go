@@ -1,45 +1,100 @@
 package connectors
 
 import (
+	"context"
 	"crypto/tls"
 	"fmt"
+	"net/url"
 	"strconv"
-	"time"
 
-	"github.com/pkg/errors"
 	"gopkg.in/resty.v1"
 )
 
 // BaseClient implement the kafka-connect contract as a client
-// handle retries on 409 response
+// handle retries according to a RetryPolicy, see DefaultRetryPolicy
 type BaseClient interface {
+	GetWorkerInfo() (WorkerInfoResponse, error)
+	GetWorkerInfoContext(ctx context.Context) (WorkerInfoResponse, error)
 	GetAll() (GetAllConnectorsResponse, error)
+	GetAllContext(ctx context.Context) (GetAllConnectorsResponse, error)
+	GetAllExpanded(expandStatus bool, expandInfo bool) (GetAllExpandedConnectorsResponse, error)
+	GetAllExpandedContext(ctx context.Context, expandStatus bool, expandInfo bool) (GetAllExpandedConnectorsResponse, error)
 	GetConnector(req ConnectorRequest) (ConnectorResponse, error)
+	GetConnectorContext(ctx context.Context, req ConnectorRequest) (ConnectorResponse, error)
 	CreateConnector(req CreateConnectorRequest) (ConnectorResponse, error)
+	CreateConnectorContext(ctx context.Context, req CreateConnectorRequest) (ConnectorResponse, error)
 	UpdateConnector(req CreateConnectorRequest) (ConnectorResponse, error)
+	UpdateConnectorContext(ctx context.Context, req CreateConnectorRequest) (ConnectorResponse, error)
 	DeleteConnector(req ConnectorRequest) (EmptyResponse, error)
+	DeleteConnectorContext(ctx context.Context, req ConnectorRequest) (EmptyResponse, error)
 	GetConnectorConfig(req ConnectorRequest) (GetConnectorConfigResponse, error)
+	GetConnectorConfigContext(ctx context.Context, req ConnectorRequest) (GetConnectorConfigResponse, error)
 	GetConnectorStatus(req ConnectorRequest) (GetConnectorStatusResponse, error)
+	GetConnectorStatusContext(ctx context.Context, req ConnectorRequest) (GetConnectorStatusResponse, error)
 	RestartConnector(req ConnectorRequest) (EmptyResponse, error)
+	RestartConnectorContext(ctx context.Context, req ConnectorRequest) (EmptyResponse, error)
+	RestartConnectorWithOptions(req RestartConnectorRequest) (RestartConnectorResponse, error)
+	RestartConnectorWithOptionsContext(ctx context.Context, req RestartConnectorRequest) (RestartConnectorResponse, error)
 	PauseConnector(req ConnectorRequest) (EmptyResponse, error)
+	PauseConnectorContext(ctx context.Context, req ConnectorRequest) (EmptyResponse, error)
 	ResumeConnector(req ConnectorRequest) (EmptyResponse, error)
+	ResumeConnectorContext(ctx context.Context, req ConnectorRequest) (EmptyResponse, error)
+	StopConnector(req ConnectorRequest) (EmptyResponse, error)
+	StopConnectorContext(ctx context.Context, req ConnectorRequest) (EmptyResponse, error)
 	GetAllTasks(req ConnectorRequest) (GetAllTasksResponse, error)
+	GetAllTasksContext(ctx context.Context, req ConnectorRequest) (GetAllTasksResponse, error)
 	GetTaskStatus(req TaskRequest) (TaskStatusResponse, error)
+	GetTaskStatusContext(ctx context.Context, req TaskRequest) (TaskStatusResponse, error)
 	RestartTask(req TaskRequest) (EmptyResponse, error)
+	RestartTaskContext(ctx context.Context, req TaskRequest) (EmptyResponse, error)
+	GetConnectorPlugins() (GetConnectorPluginsResponse, error)
+	GetConnectorPluginsContext(ctx context.Context) (GetConnectorPluginsResponse, error)
+	ValidateConnectorConfig(req ValidateConnectorConfigRequest) (ValidateConnectorConfigResponse, error)
+	ValidateConnectorConfigContext(ctx context.Context, req ValidateConnectorConfigRequest) (ValidateConnectorConfigResponse, error)
+	GetConnectorOffsets(req ConnectorRequest) (GetConnectorOffsetsResponse, error)
+	GetConnectorOffsetsContext(ctx context.Context, req ConnectorRequest) (GetConnectorOffsetsResponse, error)
+	AlterConnectorOffsets(req AlterConnectorOffsetsRequest) (ConnectorOffsetsMessageResponse, error)
+	AlterConnectorOffsetsContext(ctx context.Context, req AlterConnectorOffsetsRequest) (ConnectorOffsetsMessageResponse, error)
+	ResetConnectorOffsets(req ConnectorRequest) (ConnectorOffsetsMessageResponse, error)
+	ResetConnectorOffsetsContext(ctx context.Context, req ConnectorRequest) (ConnectorOffsetsMessageResponse, error)
+	GetConnectorTopics(req ConnectorRequest) (GetConnectorTopicsResponse, error)
+	GetConnectorTopicsContext(ctx context.Context, req ConnectorRequest) (GetConnectorTopicsResponse, error)
+	ResetConnectorTopics(req ConnectorRequest) (EmptyResponse, error)
+	ResetConnectorTopicsContext(ctx context.Context, req ConnectorRequest) (EmptyResponse, error)
+	GetLoggers() (GetLoggersResponse, error)
+	GetLoggersContext(ctx context.Context) (GetLoggersResponse, error)
+	GetLogger(req LoggerRequest) (GetLoggerResponse, error)
+	GetLoggerContext(ctx context.Context, req LoggerRequest) (GetLoggerResponse, error)
+	SetLogLevel(req SetLogLevelRequest) (SetLogLevelResponse, error)
+	SetLogLevelContext(ctx context.Context, req SetLogLevelRequest) (SetLogLevelResponse, error)
 
 	SetInsecureSSL()
 	SetDebug()
 	SetClientCertificates(certs ...tls.Certificate)
 	SetBasicAuth(username string, password string)
+	SetTokenSource(source TokenSource)
 	SetHeader(name string, value string)
+	SetNotFoundAsError(enabled bool)
+	AddInterceptor(interceptors ...Interceptor)
+	SetLogger(logger Logger)
+	SetRateLimit(requestsPerSecond float64, burst int)
+	SetMaxInFlight(max int)
 }
 
 type baseClient struct {
-	restClient *resty.Client
+	restClient      *resty.Client
+	retryPolicy     RetryPolicy
+	tokenSource     TokenSource
+	notFoundAsError bool
+	interceptors    []Interceptor
+	logger          Logger
+	limiter         *requestLimiter
 }
 
 func (c *baseClient) SetInsecureSSL() {
-	c.restClient.SetTLSClientConfig(&tls.Config{InsecureSkipVerify: true})
+	c.updateTLSConfig(func(config *tls.Config) {
+		config.InsecureSkipVerify = true
+	})
 }
 
 func (c *baseClient) SetDebug() {
@@ -47,17 +102,52 @@ func (c *baseClient) SetDebug() {
 }
 
 func (c *baseClient) SetClientCertificates(certs ...tls.Certificate) {
-	c.restClient.SetCertificates(certs...)
+	c.updateTLSConfig(func(config *tls.Config) {
+		config.Certificates = append(config.Certificates, certs...)
+	})
+}
+
+// updateTLSConfig changes the TLS config requests are sent with, keeping what options set
+// The transport is replaced by a copy holding the changed config, it is never changed itself
+func (c *baseClient) updateTLSConfig(update func(config *tls.Config)) {
+	httpClient := c.restClient.GetClient()
+	pool, isPool := httpClient.Transport.(*workerPool)
+	current := httpClient.Transport
+	if isPool {
+		current = pool.transport()
+	}
+
+	transport, err := cloneTransportTLS(current, update)
+	if err != nil {
+		c.restClient.Log.Printf("ERROR %v", err)
+		return
+	}
+	if isPool {
+		pool.setTransport(transport)
+		return
+	}
+	httpClient.Transport = transport
 }
 
 func (c *baseClient) SetBasicAuth(username string, password string) {
 	c.restClient.SetBasicAuth(username, password)
 }
 
+//SetTokenSource sends a bearer token provided by source with every request
+func (c *baseClient) SetTokenSource(source TokenSource) {
+	c.tokenSource = source
+}
+
 func (c *baseClient) SetHeader(name string, value string) {
 	c.restClient.SetHeader(name, value)
 }
 
+//SetNotFoundAsError makes get endpoints return an error matching ErrNotFound when nothing is found,
+//instead of a response with Code 404
+func (c *baseClient) SetNotFoundAsError(enabled bool) {
+	c.notFoundAsError = enabled
+}
+
 //ErrorResponse is generic error returned by kafka connect
 type ErrorResponse struct {
 	ErrorCode int    `json:"error_code,omitempty"`
@@ -69,19 +159,64 @@ func (err ErrorResponse) Error() string {
 }
 
 func newBaseClient(url string) BaseClient {
-	restClient := resty.New().
-		SetError(ErrorResponse{}).
-		SetHostURL(url).
-		SetHeader("Accept", "application/json").
-		SetRetryCount(5).
-		SetRetryWaitTime(500 * time.Millisecond).
-		SetRetryMaxWaitTime(5 * time.Second).
-		SetTimeout(10 * time.Second).
-		AddRetryCondition(func(resp *resty.Response) (bool, error) {
-			return resp.StatusCode() == 409, nil
-		})
+	// default options cannot fail
+	client, _ := newBaseClientWithOptions(url, defaultClientOptions())
+	return client
+}
 
-	return &baseClient{restClient: restClient}
+func newBaseClientWithOptions(url string, options clientOptions) (*baseClient, error) {
+	restClient, err := options.newRestClient(url)
+	if err != nil {
+		return nil, err
+	}
+	client := &baseClient{
+		restClient:   restClient,
+		retryPolicy:  options.retryPolicy,
+		tokenSource:  options.tokenSource,
+		interceptors: options.interceptors,
+		logger:       options.logger,
+		limiter:      newRequestLimiter(options.rateLimit, options.rateBurst, options.maxInFlight),
+	}
+	restClient.SetPreRequestHook(client.beforeRequest)
+	return client, nil
+}
+
+// newRequest prepares a request bound to the given context, sent by operation about the given connector, if any
+func (c *baseClient) newRequest(ctx context.Context, operation string, connector string) *resty.Request {
+	return c.restClient.NewRequest().SetContext(context.WithValue(ctx, callKey{}, &call{operation: operation, connector: connector}))
+}
+
+// ------------- Worker ------------
+
+//WorkerInfoResponse is response returned by the root endpoint of a worker
+type WorkerInfoResponse struct {
+	EmptyResponse
+	Version        string `json:"version"`
+	Commit         string `json:"commit"`
+	KafkaClusterID string `json:"kafka_cluster_id"`
+}
+
+//GetWorkerInfo return version of the worker and id of the kafka cluster it is connected to
+func (c *baseClient) GetWorkerInfo() (WorkerInfoResponse, error) {
+	return c.GetWorkerInfoContext(context.Background())
+}
+
+//GetWorkerInfoContext is GetWorkerInfo with a context, which is used to cancel the request
+func (c *baseClient) GetWorkerInfoContext(ctx context.Context) (WorkerInfoResponse, error) {
+	result := WorkerInfoResponse{}
+
+	request := c.newRequest(ctx, "GetWorkerInfo", "").
+		SetResult(&result)
+	resp, err := c.execute(request, resty.MethodGet, "")
+	if err != nil {
+		return WorkerInfoResponse{}, err
+	}
+	if resp.StatusCode() >= 400 {
+		return WorkerInfoResponse{}, newAPIError("Get worker info", resp)
+	}
+
+	result.Code = resp.StatusCode()
+	return result, nil
 }
 
 // ------------- Connectors ------------
@@ -101,6 +236,11 @@ type EmptyResponse struct {
 type CreateConnectorRequest struct {
 	ConnectorRequest
 	Config map[string]interface{} `json:"config"`
+	// InitialState is the state CreateConnector creates the connector in, StateRunning if empty,
+	// StatePaused or StateStopped require kafka-connect 3.7 or later
+	InitialState string `json:"initial_state,omitempty"`
+	// DeployOptions overrides the deploy options of the client for this connector, it is not sent to kafka-connect
+	DeployOptions *DeployOptions `json:"-"`
 }
 
 //GetAllConnectorsResponse is request used to get list of available connectors
@@ -109,6 +249,19 @@ type GetAllConnectorsResponse struct {
 	Connectors []string
 }
 
+//GetAllExpandedConnectorsResponse is response returned by get all connectors endpoint when expanded
+//Connectors are indexed by name
+type GetAllExpandedConnectorsResponse struct {
+	EmptyResponse
+	Connectors map[string]ExpandedConnector
+}
+
+//ExpandedConnector gathers a connector details and status, only parts that were requested are filled
+type ExpandedConnector struct {
+	Info   ConnectorResponse          `json:"info"`
+	Status GetConnectorStatusResponse `json:"status"`
+}
+
 //ConnectorResponse is generic response when interacting with connector endpoint
 type ConnectorResponse struct {
 	EmptyResponse
@@ -123,6 +276,16 @@ type GetConnectorConfigResponse struct {
 	Config map[string]interface{}
 }
 
+//States a connector or a task can be in, as reported by GetStatus endpoint
+const (
+	StateRunning    = "RUNNING"
+	StatePaused     = "PAUSED"
+	StateStopped    = "STOPPED"
+	StateFailed     = "FAILED"
+	StateUnassigned = "UNASSIGNED"
+	StateRestarting = "RESTARTING"
+)
+
 //GetConnectorStatusResponse is response returned by GetStatus endpoint
 type GetConnectorStatusResponse struct {
 	EmptyResponse
@@ -133,18 +296,23 @@ type GetConnectorStatusResponse struct {
 
 //GetAll gets the list of all active connectors
 func (c *baseClient) GetAll() (GetAllConnectorsResponse, error) {
+	return c.GetAllContext(context.Background())
+}
+
+//GetAllContext is GetAll with a context, which is used to cancel the request
+func (c *baseClient) GetAllContext(ctx context.Context) (GetAllConnectorsResponse, error) {
 	result := GetAllConnectorsResponse{}
 	var connectors []string
 
-	resp, err := c.restClient.NewRequest().
-		SetResult(&connectors).
-		Get("connectors")
+	request := c.newRequest(ctx, "GetAll", "").
+		SetResult(&connectors)
+	resp, err := c.execute(request, resty.MethodGet, "connectors")
 
 	if err != nil {
 		return GetAllConnectorsResponse{}, err
 	}
 	if resp.StatusCode() >= 400 {
-		return GetAllConnectorsResponse{}, errors.Errorf("Get all connector : %v", resp.String())
+		return GetAllConnectorsResponse{}, newAPIError("Get all connector", resp)
 	}
 
 	result.Code = resp.StatusCode()
@@ -153,20 +321,73 @@ func (c *baseClient) GetAll() (GetAllConnectorsResponse, error) {
 	return result, nil
 }
 
+//GetAllExpanded gets all active connectors along with their status and/or info in a single call
+func (c *baseClient) GetAllExpanded(expandStatus bool, expandInfo bool) (GetAllExpandedConnectorsResponse, error) {
+	return c.GetAllExpandedContext(context.Background(), expandStatus, expandInfo)
+}
+
+//GetAllExpandedContext is GetAllExpanded with a context, which is used to cancel the request
+func (c *baseClient) GetAllExpandedContext(ctx context.Context, expandStatus bool, expandInfo bool) (GetAllExpandedConnectorsResponse, error) {
+	result := GetAllExpandedConnectorsResponse{}
+
+	// without expand, kafka-connect returns only the list of names
+	if !expandStatus && !expandInfo {
+		all, err := c.GetAllContext(ctx)
+		if err != nil {
+			return GetAllExpandedConnectorsResponse{}, err
+		}
+		result.Code = all.Code
+		result.Connectors = make(map[string]ExpandedConnector, len(all.Connectors))
+		for _, name := range all.Connectors {
+			result.Connectors[name] = ExpandedConnector{}
+		}
+		return result, nil
+	}
+
+	expand := url.Values{}
+	if expandStatus {
+		expand.Add("expand", "status")
+	}
+	if expandInfo {
+		expand.Add("expand", "info")
+	}
+
+	var connectors map[string]ExpandedConnector
+	request := c.newRequest(ctx, "GetAllExpanded", "").
+		SetMultiValueQueryParams(expand).
+		SetResult(&connectors)
+	resp, err := c.execute(request, resty.MethodGet, "connectors")
+	if err != nil {
+		return GetAllExpandedConnectorsResponse{}, err
+	}
+	if resp.StatusCode() >= 400 {
+		return GetAllExpandedConnectorsResponse{}, newAPIError("Get all expanded connector", resp)
+	}
+
+	result.Code = resp.StatusCode()
+	result.Connectors = connectors
+	return result, nil
+}
+
 //GetConnector return information on specific connector
 func (c *baseClient) GetConnector(req ConnectorRequest) (ConnectorResponse, error) {
+	return c.GetConnectorContext(context.Background(), req)
+}
+
+//GetConnectorContext is GetConnector with a context, which is used to cancel the request
+func (c *baseClient) GetConnectorContext(ctx context.Context, req ConnectorRequest) (ConnectorResponse, error) {
 	result := ConnectorResponse{}
 
-	resp, err := c.restClient.NewRequest().
+	request := c.newRequest(ctx, "GetConnector", req.Name).
 		SetResult(&result).
-		SetPathParams(map[string]string{"name": req.Name}).
-		Get("connectors/{name}")
+		SetPathParams(map[string]string{"name": req.Name})
+	resp, err := c.execute(request, resty.MethodGet, "connectors/{name}")
 	if err != nil {
 		return ConnectorResponse{}, err
 	}
 
-	if resp.StatusCode() >= 400 && resp.StatusCode() != 404 {
-		return ConnectorResponse{}, errors.Errorf("Get connector : %v", resp.String())
+	if resp.StatusCode() >= 400 && (resp.StatusCode() != 404 || c.notFoundAsError) {
+		return ConnectorResponse{}, newAPIError("Get connector", resp)
 	}
 
 	result.Code = resp.StatusCode()
@@ -175,17 +396,22 @@ func (c *baseClient) GetConnector(req ConnectorRequest) (ConnectorResponse, erro
 
 //CreateConnector create connector using specified config and name
 func (c *baseClient) CreateConnector(req CreateConnectorRequest) (ConnectorResponse, error) {
+	return c.CreateConnectorContext(context.Background(), req)
+}
+
+//CreateConnectorContext is CreateConnector with a context, which is used to cancel the request
+func (c *baseClient) CreateConnectorContext(ctx context.Context, req CreateConnectorRequest) (ConnectorResponse, error) {
 	result := ConnectorResponse{}
 
-	resp, err := c.restClient.NewRequest().
+	request := c.newRequest(ctx, "CreateConnector", req.Name).
 		SetBody(req).
-		SetResult(&result).
-		Post("connectors")
+		SetResult(&result)
+	resp, err := c.execute(request, resty.MethodPost, "connectors")
 	if err != nil {
 		return ConnectorResponse{}, err
 	}
 	if resp.StatusCode() >= 400 {
-		return ConnectorResponse{}, errors.Errorf("Create connector : %v", resp.String())
+		return ConnectorResponse{}, newAPIError("Create connector", resp)
 	}
 
 	result.Code = resp.StatusCode()
@@ -195,18 +421,23 @@ func (c *baseClient) CreateConnector(req CreateConnectorRequest) (ConnectorRespo
 
 //UpdateConnector update a connector config
 func (c *baseClient) UpdateConnector(req CreateConnectorRequest) (ConnectorResponse, error) {
+	return c.UpdateConnectorContext(context.Background(), req)
+}
+
+//UpdateConnectorContext is UpdateConnector with a context, which is used to cancel the request
+func (c *baseClient) UpdateConnectorContext(ctx context.Context, req CreateConnectorRequest) (ConnectorResponse, error) {
 	result := ConnectorResponse{}
 
-	resp, err := c.restClient.NewRequest().
+	request := c.newRequest(ctx, "UpdateConnector", req.Name).
 		SetPathParams(map[string]string{"name": req.Name}).
 		SetBody(req.Config).
-		SetResult(&result).
-		Put("connectors/{name}/config")
+		SetResult(&result)
+	resp, err := c.execute(request, resty.MethodPut, "connectors/{name}/config")
 	if err != nil {
 		return ConnectorResponse{}, err
 	}
 	if resp.StatusCode() >= 400 {
-		return ConnectorResponse{}, errors.Errorf("Update connector : %v", resp.String())
+		return ConnectorResponse{}, newAPIError("Update connector", resp)
 	}
 
 	result.Code = resp.StatusCode()
@@ -216,17 +447,22 @@ func (c *baseClient) UpdateConnector(req CreateConnectorRequest) (ConnectorRespo
 
 //DeleteConnector delete a connector
 func (c *baseClient) DeleteConnector(req ConnectorRequest) (EmptyResponse, error) {
+	return c.DeleteConnectorContext(context.Background(), req)
+}
+
+//DeleteConnectorContext is DeleteConnector with a context, which is used to cancel the request
+func (c *baseClient) DeleteConnectorContext(ctx context.Context, req ConnectorRequest) (EmptyResponse, error) {
 	result := EmptyResponse{}
 
-	resp, err := c.restClient.NewRequest().
+	request := c.newRequest(ctx, "DeleteConnector", req.Name).
 		SetResult(&result).
-		SetPathParams(map[string]string{"name": req.Name}).
-		Delete("connectors/{name}")
+		SetPathParams(map[string]string{"name": req.Name})
+	resp, err := c.execute(request, resty.MethodDelete, "connectors/{name}")
 	if err != nil {
 		return EmptyResponse{}, err
 	}
 	if resp.StatusCode() >= 400 {
-		return EmptyResponse{}, errors.Errorf("Delete connector : %v", resp.String())
+		return EmptyResponse{}, newAPIError("Delete connector", resp)
 	}
 
 	result.Code = resp.StatusCode()
@@ -236,18 +472,23 @@ func (c *baseClient) DeleteConnector(req ConnectorRequest) (EmptyResponse, error
 
 ////GetConnectorConfig return config of a connector
 func (c *baseClient) GetConnectorConfig(req ConnectorRequest) (GetConnectorConfigResponse, error) {
+	return c.GetConnectorConfigContext(context.Background(), req)
+}
+
+//GetConnectorConfigContext is GetConnectorConfig with a context, which is used to cancel the request
+func (c *baseClient) GetConnectorConfigContext(ctx context.Context, req ConnectorRequest) (GetConnectorConfigResponse, error) {
 	result := GetConnectorConfigResponse{}
 	var config map[string]interface{}
 
-	resp, err := c.restClient.NewRequest().
+	request := c.newRequest(ctx, "GetConnectorConfig", req.Name).
 		SetResult(&config).
-		SetPathParams(map[string]string{"name": req.Name}).
-		Get("connectors/{name}/config")
+		SetPathParams(map[string]string{"name": req.Name})
+	resp, err := c.execute(request, resty.MethodGet, "connectors/{name}/config")
 	if err != nil {
 		return GetConnectorConfigResponse{}, err
 	}
-	if resp.StatusCode() >= 400 && resp.StatusCode() != 404 {
-		return GetConnectorConfigResponse{}, errors.Errorf("Get connector config : %v", resp.String())
+	if resp.StatusCode() >= 400 && (resp.StatusCode() != 404 || c.notFoundAsError) {
+		return GetConnectorConfigResponse{}, newAPIError("Get connector config", resp)
 	}
 
 	result.Code = resp.StatusCode()
@@ -257,17 +498,22 @@ func (c *baseClient) GetConnectorConfig(req ConnectorRequest) (GetConnectorConfi
 
 //GetConnectorStatus return current status of connector
 func (c *baseClient) GetConnectorStatus(req ConnectorRequest) (GetConnectorStatusResponse, error) {
+	return c.GetConnectorStatusContext(context.Background(), req)
+}
+
+//GetConnectorStatusContext is GetConnectorStatus with a context, which is used to cancel the request
+func (c *baseClient) GetConnectorStatusContext(ctx context.Context, req ConnectorRequest) (GetConnectorStatusResponse, error) {
 	result := GetConnectorStatusResponse{}
 
-	resp, err := c.restClient.NewRequest().
+	request := c.newRequest(ctx, "GetConnectorStatus", req.Name).
 		SetResult(&result).
-		SetPathParams(map[string]string{"name": req.Name}).
-		Get("connectors/{name}/status")
+		SetPathParams(map[string]string{"name": req.Name})
+	resp, err := c.execute(request, resty.MethodGet, "connectors/{name}/status")
 	if err != nil {
 		return GetConnectorStatusResponse{}, err
 	}
-	if resp.StatusCode() >= 400 && resp.StatusCode() != 404 {
-		return GetConnectorStatusResponse{}, errors.Errorf("Get connector status : %v", resp.String())
+	if resp.StatusCode() >= 400 && (resp.StatusCode() != 404 || c.notFoundAsError) {
+		return GetConnectorStatusResponse{}, newAPIError("Get connector status", resp)
 	}
 
 	result.Code = resp.StatusCode()
@@ -276,17 +522,67 @@ func (c *baseClient) GetConnectorStatus(req ConnectorRequest) (GetConnectorStatu
 
 //RestartConnector restart connector
 func (c *baseClient) RestartConnector(req ConnectorRequest) (EmptyResponse, error) {
+	return c.RestartConnectorContext(context.Background(), req)
+}
+
+//RestartConnectorContext is RestartConnector with a context, which is used to cancel the request
+func (c *baseClient) RestartConnectorContext(ctx context.Context, req ConnectorRequest) (EmptyResponse, error) {
 	result := EmptyResponse{}
 
-	resp, err := c.restClient.NewRequest().
+	request := c.newRequest(ctx, "RestartConnector", req.Name).
 		SetResult(&result).
-		SetPathParams(map[string]string{"name": req.Name}).
-		Post("connectors/{name}/restart")
+		SetPathParams(map[string]string{"name": req.Name})
+	resp, err := c.execute(request, resty.MethodPost, "connectors/{name}/restart")
 	if err != nil {
 		return EmptyResponse{}, err
 	}
 	if resp.StatusCode() >= 400 {
-		return EmptyResponse{}, errors.Errorf("Restart connector : %v", resp.String())
+		return EmptyResponse{}, newAPIError("Restart connector", resp)
+	}
+
+	result.Code = resp.StatusCode()
+	return result, nil
+}
+
+//RestartConnectorRequest is request used to restart a connector and, optionally, its tasks
+type RestartConnectorRequest struct {
+	ConnectorRequest
+	// IncludeTasks restarts tasks along with the connector
+	IncludeTasks bool
+	// OnlyFailed restarts only the instances (connector and/or tasks) which are FAILED
+	OnlyFailed bool
+}
+
+//RestartConnectorResponse is response returned by restart connector endpoint when called with options
+//It contains the connector status right after restart, instances being restarted are in RESTARTING state
+type RestartConnectorResponse struct {
+	GetConnectorStatusResponse
+	// FailedTasks is only filled by HighLevelClient in sync mode: restarted tasks that ended FAILED
+	FailedTasks []TaskStatus
+}
+
+//RestartConnectorWithOptions restart connector and, depending on options, its tasks (kafka-connect 3.0+)
+func (c *baseClient) RestartConnectorWithOptions(req RestartConnectorRequest) (RestartConnectorResponse, error) {
+	return c.RestartConnectorWithOptionsContext(context.Background(), req)
+}
+
+//RestartConnectorWithOptionsContext is RestartConnectorWithOptions with a context, which is used to cancel the request
+func (c *baseClient) RestartConnectorWithOptionsContext(ctx context.Context, req RestartConnectorRequest) (RestartConnectorResponse, error) {
+	result := RestartConnectorResponse{}
+
+	request := c.newRequest(ctx, "RestartConnectorWithOptions", req.Name).
+		SetResult(&result).
+		SetPathParams(map[string]string{"name": req.Name}).
+		SetQueryParams(map[string]string{
+			"includeTasks": strconv.FormatBool(req.IncludeTasks),
+			"onlyFailed":   strconv.FormatBool(req.OnlyFailed),
+		})
+	resp, err := c.execute(request, resty.MethodPost, "connectors/{name}/restart")
+	if err != nil {
+		return RestartConnectorResponse{}, err
+	}
+	if resp.StatusCode() >= 400 {
+		return RestartConnectorResponse{}, newAPIError("Restart connector", resp)
 	}
 
 	result.Code = resp.StatusCode()
@@ -296,17 +592,22 @@ func (c *baseClient) RestartConnector(req ConnectorRequest) (EmptyResponse, erro
 //PauseConnector pause a running connector
 //asynchronous operation
 func (c *baseClient) PauseConnector(req ConnectorRequest) (EmptyResponse, error) {
+	return c.PauseConnectorContext(context.Background(), req)
+}
+
+//PauseConnectorContext is PauseConnector with a context, which is used to cancel the request
+func (c *baseClient) PauseConnectorContext(ctx context.Context, req ConnectorRequest) (EmptyResponse, error) {
 	result := EmptyResponse{}
 
-	resp, err := c.restClient.NewRequest().
+	request := c.newRequest(ctx, "PauseConnector", req.Name).
 		SetResult(&result).
-		SetPathParams(map[string]string{"name": req.Name}).
-		Put("connectors/{name}/pause")
+		SetPathParams(map[string]string{"name": req.Name})
+	resp, err := c.execute(request, resty.MethodPut, "connectors/{name}/pause")
 	if err != nil {
 		return EmptyResponse{}, err
 	}
 	if resp.StatusCode() >= 400 {
-		return EmptyResponse{}, errors.Errorf("Pause connector : %v", resp.String())
+		return EmptyResponse{}, newAPIError("Pause connector", resp)
 	}
 
 	result.Code = resp.StatusCode()
@@ -317,17 +618,48 @@ func (c *baseClient) PauseConnector(req ConnectorRequest) (EmptyResponse, error)
 //ResumeConnector resume a paused connector
 //asynchronous operation
 func (c *baseClient) ResumeConnector(req ConnectorRequest) (EmptyResponse, error) {
+	return c.ResumeConnectorContext(context.Background(), req)
+}
+
+//ResumeConnectorContext is ResumeConnector with a context, which is used to cancel the request
+func (c *baseClient) ResumeConnectorContext(ctx context.Context, req ConnectorRequest) (EmptyResponse, error) {
 	result := EmptyResponse{}
 
-	resp, err := c.restClient.NewRequest().
+	request := c.newRequest(ctx, "ResumeConnector", req.Name).
 		SetResult(&result).
-		SetPathParams(map[string]string{"name": req.Name}).
-		Put("connectors/{name}/resume")
+		SetPathParams(map[string]string{"name": req.Name})
+	resp, err := c.execute(request, resty.MethodPut, "connectors/{name}/resume")
 	if err != nil {
 		return EmptyResponse{}, err
 	}
 	if resp.StatusCode() >= 400 {
-		return EmptyResponse{}, errors.Errorf("Resume connector : %v", resp.String())
+		return EmptyResponse{}, newAPIError("Resume connector", resp)
+	}
+
+	result.Code = resp.StatusCode()
+
+	return result, nil
+}
+
+//StopConnector stop a connector, shutting down its tasks without deleting its config nor offsets
+//asynchronous operation
+func (c *baseClient) StopConnector(req ConnectorRequest) (EmptyResponse, error) {
+	return c.StopConnectorContext(context.Background(), req)
+}
+
+//StopConnectorContext is StopConnector with a context, which is used to cancel the request
+func (c *baseClient) StopConnectorContext(ctx context.Context, req ConnectorRequest) (EmptyResponse, error) {
+	result := EmptyResponse{}
+
+	request := c.newRequest(ctx, "StopConnector", req.Name).
+		SetResult(&result).
+		SetPathParams(map[string]string{"name": req.Name})
+	resp, err := c.execute(request, resty.MethodPut, "connectors/{name}/stop")
+	if err != nil {
+		return EmptyResponse{}, err
+	}
+	if resp.StatusCode() >= 400 {
+		return EmptyResponse{}, newAPIError("Stop connector", resp)
 	}
 
 	result.Code = resp.StatusCode()
@@ -377,17 +709,22 @@ type TaskStatus struct {
 
 //GetAllTasks return list of running task
 func (c *baseClient) GetAllTasks(req ConnectorRequest) (GetAllTasksResponse, error) {
+	return c.GetAllTasksContext(context.Background(), req)
+}
+
+//GetAllTasksContext is GetAllTasks with a context, which is used to cancel the request
+func (c *baseClient) GetAllTasksContext(ctx context.Context, req ConnectorRequest) (GetAllTasksResponse, error) {
 	var result GetAllTasksResponse
 
-	resp, err := c.restClient.NewRequest().
+	request := c.newRequest(ctx, "GetAllTasks", req.Name).
 		SetResult(&result.Tasks).
-		SetPathParams(map[string]string{"name": req.Name}).
-		Get("connectors/{name}/tasks")
+		SetPathParams(map[string]string{"name": req.Name})
+	resp, err := c.execute(request, resty.MethodGet, "connectors/{name}/tasks")
 	if err != nil {
 		return GetAllTasksResponse{}, err
 	}
 	if resp.StatusCode() >= 400 {
-		return GetAllTasksResponse{}, errors.Errorf("Get all tasks : %v", resp.String())
+		return GetAllTasksResponse{}, newAPIError("Get all tasks", resp)
 	}
 
 	result.Code = resp.StatusCode()
@@ -396,17 +733,22 @@ func (c *baseClient) GetAllTasks(req ConnectorRequest) (GetAllTasksResponse, err
 
 //GetTaskStatus return current status of task
 func (c *baseClient) GetTaskStatus(req TaskRequest) (TaskStatusResponse, error) {
+	return c.GetTaskStatusContext(context.Background(), req)
+}
+
+//GetTaskStatusContext is GetTaskStatus with a context, which is used to cancel the request
+func (c *baseClient) GetTaskStatusContext(ctx context.Context, req TaskRequest) (TaskStatusResponse, error) {
 	var result TaskStatusResponse
 
-	resp, err := c.restClient.NewRequest().
+	request := c.newRequest(ctx, "GetTaskStatus", req.Connector).
 		SetResult(&result).
-		SetPathParams(map[string]string{"name": req.Connector, "task_id": strconv.Itoa(req.TaskID)}).
-		Get("connectors/{name}/tasks/{task_id}/status")
+		SetPathParams(map[string]string{"name": req.Connector, "task_id": strconv.Itoa(req.TaskID)})
+	resp, err := c.execute(request, resty.MethodGet, "connectors/{name}/tasks/{task_id}/status")
 	if err != nil {
 		return TaskStatusResponse{}, err
 	}
-	if resp.StatusCode() >= 400 && resp.StatusCode() != 404 {
-		return TaskStatusResponse{}, errors.Errorf("Get task status : %v", resp.String())
+	if resp.StatusCode() >= 400 && (resp.StatusCode() != 404 || c.notFoundAsError) {
+		return TaskStatusResponse{}, newAPIError("Get task status", resp)
 	}
 
 	result.Code = resp.StatusCode()
@@ -416,20 +758,527 @@ func (c *baseClient) GetTaskStatus(req TaskRequest) (TaskStatusResponse, error)
 
 //RestartTask try to restart task
 func (c *baseClient) RestartTask(req TaskRequest) (EmptyResponse, error) {
+	return c.RestartTaskContext(context.Background(), req)
+}
+
+//RestartTaskContext is RestartTask with a context, which is used to cancel the request
+func (c *baseClient) RestartTaskContext(ctx context.Context, req TaskRequest) (EmptyResponse, error) {
 	var result EmptyResponse
 
-	resp, err := c.restClient.NewRequest().
+	request := c.newRequest(ctx, "RestartTask", req.Connector).
+		SetResult(&result).
+		SetPathParams(map[string]string{"name": req.Connector, "task_id": strconv.Itoa(req.TaskID)})
+	resp, err := c.execute(request, resty.MethodPost, "connectors/{name}/tasks/{task_id}/restart")
+	if err != nil {
+		return EmptyResponse{}, err
+	}
+	if resp.StatusCode() >= 400 {
+		return EmptyResponse{}, newAPIError("Restart task", resp)
+	}
+
+	result.Code = resp.StatusCode()
+
+	return result, nil
+}
+
+// ----------- Connector plugins ---------
+
+//ConnectorPlugin describes a connector plugin installed on the kafka-connect cluster
+type ConnectorPlugin struct {
+	Class   string `json:"class"`
+	Type    string `json:"type"`
+	Version string `json:"version"`
+}
+
+//GetConnectorPluginsResponse is response returned by get connector plugins endpoint
+type GetConnectorPluginsResponse struct {
+	EmptyResponse
+	Plugins []ConnectorPlugin
+}
+
+//ValidateConnectorConfigRequest is request used to validate a config against a connector plugin
+type ValidateConnectorConfigRequest struct {
+	Class  string
+	Config map[string]interface{}
+}
+
+//ValidateConnectorConfigResponse is response returned by validate connector config endpoint
+type ValidateConnectorConfigResponse struct {
+	EmptyResponse
+	Name       string             `json:"name"`
+	ErrorCount int                `json:"error_count"`
+	Groups     []string           `json:"groups"`
+	Configs    []ConfigValidation `json:"configs"`
+}
+
+//ConfigValidation is the validation result of a single config field
+type ConfigValidation struct {
+	Definition ConfigDefinition `json:"definition"`
+	Value      ConfigValue      `json:"value"`
+}
+
+//ConfigDefinition describes a config field as declared by the connector plugin
+type ConfigDefinition struct {
+	Name          string   `json:"name"`
+	Type          string   `json:"type"`
+	Required      bool     `json:"required"`
+	DefaultValue  *string  `json:"default_value"`
+	Importance    string   `json:"importance"`
+	Documentation string   `json:"documentation"`
+	Group         string   `json:"group"`
+	Width         string   `json:"width"`
+	DisplayName   string   `json:"display_name"`
+	Dependents    []string `json:"dependents"`
+	Order         int      `json:"order"`
+}
+
+//ConfigValue is the value of a config field as seen by the connector plugin
+type ConfigValue struct {
+	Name              string   `json:"name"`
+	Value             *string  `json:"value"`
+	RecommendedValues []string `json:"recommended_values"`
+	Errors            []string `json:"errors"`
+	Visible           bool     `json:"visible"`
+}
+
+//FieldErrors returns validation errors indexed by config field name
+//fields without error are not part of the result
+func (r ValidateConnectorConfigResponse) FieldErrors() map[string][]string {
+	result := map[string][]string{}
+	for _, config := range r.Configs {
+		if len(config.Value.Errors) > 0 {
+			result[config.Value.Name] = config.Value.Errors
+		}
+	}
+	return result
+}
+
+//GetConnectorPlugins return the list of connector plugins installed on the cluster
+func (c *baseClient) GetConnectorPlugins() (GetConnectorPluginsResponse, error) {
+	return c.GetConnectorPluginsContext(context.Background())
+}
+
+//GetConnectorPluginsContext is GetConnectorPlugins with a context, which is used to cancel the request
+func (c *baseClient) GetConnectorPluginsContext(ctx context.Context) (GetConnectorPluginsResponse, error) {
+	result := GetConnectorPluginsResponse{}
+	var plugins []ConnectorPlugin
+
+	request := c.newRequest(ctx, "GetConnectorPlugins", "").
+		SetResult(&plugins)
+	resp, err := c.execute(request, resty.MethodGet, "connector-plugins")
+	if err != nil {
+		return GetConnectorPluginsResponse{}, err
+	}
+	if resp.StatusCode() >= 400 {
+		return GetConnectorPluginsResponse{}, newAPIError("Get connector plugins", resp)
+	}
+
+	result.Code = resp.StatusCode()
+	result.Plugins = plugins
+	return result, nil
+}
+
+//ValidateConnectorConfig validate a config against the given connector plugin without creating anything
+func (c *baseClient) ValidateConnectorConfig(req ValidateConnectorConfigRequest) (ValidateConnectorConfigResponse, error) {
+	return c.ValidateConnectorConfigContext(context.Background(), req)
+}
+
+//ValidateConnectorConfigContext is ValidateConnectorConfig with a context, which is used to cancel the request
+func (c *baseClient) ValidateConnectorConfigContext(ctx context.Context, req ValidateConnectorConfigRequest) (ValidateConnectorConfigResponse, error) {
+	result := ValidateConnectorConfigResponse{}
+
+	// kafka-connect requires the class to be part of the config as well
+	config := make(map[string]interface{}, len(req.Config)+1)
+	for key, value := range req.Config {
+		config[key] = value
+	}
+	if _, ok := config["connector.class"]; !ok {
+		config["connector.class"] = req.Class
+	}
+
+	request := c.newRequest(ctx, "ValidateConnectorConfig", "").
+		SetBody(config).
+		SetResult(&result).
+		SetPathParams(map[string]string{"class": req.Class})
+	resp, err := c.execute(request, resty.MethodPut, "connector-plugins/{class}/config/validate")
+	if err != nil {
+		return ValidateConnectorConfigResponse{}, err
+	}
+	if resp.StatusCode() >= 400 {
+		return ValidateConnectorConfigResponse{}, newAPIError("Validate connector config", resp)
+	}
+
+	result.Code = resp.StatusCode()
+	return result, nil
+}
+
+// ----------- Offsets ---------
+
+//ConnectorOffset is a single partition/offset pair as exposed by kafka-connect
+//Its content depends on the connector type, see SourceOffset and SinkOffset for typed versions
+type ConnectorOffset struct {
+	Partition map[string]interface{} `json:"partition"`
+	Offset    map[string]interface{} `json:"offset"`
+}
+
+//SourceOffset is the offset of a source connector, partition and offset are defined by the connector itself
+//A nil Offset resets the partition when altering offsets
+type SourceOffset struct {
+	Partition map[string]interface{}
+	Offset    map[string]interface{}
+}
+
+//SinkOffset is the offset of a sink connector on a kafka topic partition
+type SinkOffset struct {
+	Topic     string
+	Partition int
+	Offset    int64
+}
+
+func (o SourceOffset) toConnectorOffset() ConnectorOffset {
+	return ConnectorOffset{Partition: o.Partition, Offset: o.Offset}
+}
+
+func (o SinkOffset) toConnectorOffset() ConnectorOffset {
+	return ConnectorOffset{
+		Partition: map[string]interface{}{"kafka_topic": o.Topic, "kafka_partition": o.Partition},
+		Offset:    map[string]interface{}{"kafka_offset": o.Offset},
+	}
+}
+
+//SinkOffset converts the offset to a sink offset
+//returns false if it is not the offset of a sink connector
+func (o ConnectorOffset) SinkOffset() (SinkOffset, bool) {
+	topic, ok := o.Partition["kafka_topic"].(string)
+	if !ok {
+		return SinkOffset{}, false
+	}
+	partition, ok := o.Partition["kafka_partition"].(float64)
+	if !ok {
+		return SinkOffset{}, false
+	}
+	offset, ok := o.Offset["kafka_offset"].(float64)
+	if !ok {
+		return SinkOffset{}, false
+	}
+
+	return SinkOffset{Topic: topic, Partition: int(partition), Offset: int64(offset)}, true
+}
+
+//SourceOffset converts the offset to a source offset
+func (o ConnectorOffset) SourceOffset() SourceOffset {
+	return SourceOffset{Partition: o.Partition, Offset: o.Offset}
+}
+
+//GetConnectorOffsetsResponse is response returned by get connector offsets endpoint
+type GetConnectorOffsetsResponse struct {
+	EmptyResponse
+	Offsets []ConnectorOffset `json:"offsets"`
+}
+
+//SinkOffsets returns offsets of a sink connector, ignoring entries that are not sink offsets
+func (r GetConnectorOffsetsResponse) SinkOffsets() []SinkOffset {
+	var result []SinkOffset
+	for _, offset := range r.Offsets {
+		if sinkOffset, ok := offset.SinkOffset(); ok {
+			result = append(result, sinkOffset)
+		}
+	}
+	return result
+}
+
+//SourceOffsets returns offsets of a source connector
+func (r GetConnectorOffsetsResponse) SourceOffsets() []SourceOffset {
+	var result []SourceOffset
+	for _, offset := range r.Offsets {
+		result = append(result, offset.SourceOffset())
+	}
+	return result
+}
+
+//AlterConnectorOffsetsRequest is request used to alter offsets of a stopped connector
+//only one of SourceOffsets or SinkOffsets is expected to be set, depending on the connector type
+type AlterConnectorOffsetsRequest struct {
+	ConnectorRequest
+	SourceOffsets []SourceOffset
+	SinkOffsets   []SinkOffset
+}
+
+//ConnectorOffsetsMessageResponse is response returned by alter and reset connector offsets endpoints
+type ConnectorOffsetsMessageResponse struct {
+	EmptyResponse
+	Message string `json:"message"`
+}
+
+//GetConnectorOffsets return current offsets of a connector
+func (c *baseClient) GetConnectorOffsets(req ConnectorRequest) (GetConnectorOffsetsResponse, error) {
+	return c.GetConnectorOffsetsContext(context.Background(), req)
+}
+
+//GetConnectorOffsetsContext is GetConnectorOffsets with a context, which is used to cancel the request
+func (c *baseClient) GetConnectorOffsetsContext(ctx context.Context, req ConnectorRequest) (GetConnectorOffsetsResponse, error) {
+	result := GetConnectorOffsetsResponse{}
+
+	request := c.newRequest(ctx, "GetConnectorOffsets", req.Name).
+		SetResult(&result).
+		SetPathParams(map[string]string{"name": req.Name})
+	resp, err := c.execute(request, resty.MethodGet, "connectors/{name}/offsets")
+	if err != nil {
+		return GetConnectorOffsetsResponse{}, err
+	}
+	if resp.StatusCode() >= 400 {
+		return GetConnectorOffsetsResponse{}, newAPIError("Get connector offsets", resp)
+	}
+
+	result.Code = resp.StatusCode()
+	return result, nil
+}
+
+//AlterConnectorOffsets alter offsets of a connector
+//connector must be STOPPED
+func (c *baseClient) AlterConnectorOffsets(req AlterConnectorOffsetsRequest) (ConnectorOffsetsMessageResponse, error) {
+	return c.AlterConnectorOffsetsContext(context.Background(), req)
+}
+
+//AlterConnectorOffsetsContext is AlterConnectorOffsets with a context, which is used to cancel the request
+func (c *baseClient) AlterConnectorOffsetsContext(ctx context.Context, req AlterConnectorOffsetsRequest) (ConnectorOffsetsMessageResponse, error) {
+	result := ConnectorOffsetsMessageResponse{}
+
+	offsets := make([]ConnectorOffset, 0, len(req.SourceOffsets)+len(req.SinkOffsets))
+	for _, offset := range req.SourceOffsets {
+		offsets = append(offsets, offset.toConnectorOffset())
+	}
+	for _, offset := range req.SinkOffsets {
+		offsets = append(offsets, offset.toConnectorOffset())
+	}
+
+	request := c.newRequest(ctx, "AlterConnectorOffsets", req.Name).
+		SetBody(map[string]interface{}{"offsets": offsets}).
+		SetResult(&result).
+		SetPathParams(map[string]string{"name": req.Name})
+	resp, err := c.execute(request, resty.MethodPatch, "connectors/{name}/offsets")
+	if err != nil {
+		return ConnectorOffsetsMessageResponse{}, err
+	}
+	if resp.StatusCode() >= 400 {
+		return ConnectorOffsetsMessageResponse{}, newAPIError("Alter connector offsets", resp)
+	}
+
+	result.Code = resp.StatusCode()
+	return result, nil
+}
+
+//ResetConnectorOffsets reset all offsets of a connector
+//connector must be STOPPED
+func (c *baseClient) ResetConnectorOffsets(req ConnectorRequest) (ConnectorOffsetsMessageResponse, error) {
+	return c.ResetConnectorOffsetsContext(context.Background(), req)
+}
+
+//ResetConnectorOffsetsContext is ResetConnectorOffsets with a context, which is used to cancel the request
+func (c *baseClient) ResetConnectorOffsetsContext(ctx context.Context, req ConnectorRequest) (ConnectorOffsetsMessageResponse, error) {
+	result := ConnectorOffsetsMessageResponse{}
+
+	request := c.newRequest(ctx, "ResetConnectorOffsets", req.Name).
 		SetResult(&result).
-		SetPathParams(map[string]string{"name": req.Connector, "task_id": strconv.Itoa(req.TaskID)}).
-		Post("connectors/{name}/tasks/{task_id}/restart")
+		SetPathParams(map[string]string{"name": req.Name})
+	resp, err := c.execute(request, resty.MethodDelete, "connectors/{name}/offsets")
+	if err != nil {
+		return ConnectorOffsetsMessageResponse{}, err
+	}
+	if resp.StatusCode() >= 400 {
+		return ConnectorOffsetsMessageResponse{}, newAPIError("Reset connector offsets", resp)
+	}
+
+	result.Code = resp.StatusCode()
+	return result, nil
+}
+
+// ----------- Topics ---------
+
+//GetConnectorTopicsResponse is response returned by get connector topics endpoint
+//Topics are the ones the connector has actually used since its creation or last reset
+type GetConnectorTopicsResponse struct {
+	EmptyResponse
+	Topics []string
+}
+
+//GetConnectorTopics return the set of topics used by a connector
+func (c *baseClient) GetConnectorTopics(req ConnectorRequest) (GetConnectorTopicsResponse, error) {
+	return c.GetConnectorTopicsContext(context.Background(), req)
+}
+
+//GetConnectorTopicsContext is GetConnectorTopics with a context, which is used to cancel the request
+func (c *baseClient) GetConnectorTopicsContext(ctx context.Context, req ConnectorRequest) (GetConnectorTopicsResponse, error) {
+	result := GetConnectorTopicsResponse{}
+	var topics map[string]struct {
+		Topics []string `json:"topics"`
+	}
+
+	request := c.newRequest(ctx, "GetConnectorTopics", req.Name).
+		SetResult(&topics).
+		SetPathParams(map[string]string{"name": req.Name})
+	resp, err := c.execute(request, resty.MethodGet, "connectors/{name}/topics")
+	if err != nil {
+		return GetConnectorTopicsResponse{}, err
+	}
+	if resp.StatusCode() >= 400 {
+		return GetConnectorTopicsResponse{}, newAPIError("Get connector topics", resp)
+	}
+
+	result.Code = resp.StatusCode()
+	result.Topics = topics[req.Name].Topics
+	return result, nil
+}
+
+//ResetConnectorTopics empty the set of topics used by a connector
+func (c *baseClient) ResetConnectorTopics(req ConnectorRequest) (EmptyResponse, error) {
+	return c.ResetConnectorTopicsContext(context.Background(), req)
+}
+
+//ResetConnectorTopicsContext is ResetConnectorTopics with a context, which is used to cancel the request
+func (c *baseClient) ResetConnectorTopicsContext(ctx context.Context, req ConnectorRequest) (EmptyResponse, error) {
+	result := EmptyResponse{}
+
+	request := c.newRequest(ctx, "ResetConnectorTopics", req.Name).
+		SetResult(&result).
+		SetPathParams(map[string]string{"name": req.Name})
+	resp, err := c.execute(request, resty.MethodPut, "connectors/{name}/topics/reset")
 	if err != nil {
 		return EmptyResponse{}, err
 	}
 	if resp.StatusCode() >= 400 {
-		return EmptyResponse{}, errors.Errorf("Restart task : %v", resp.String())
+		return EmptyResponse{}, newAPIError("Reset connector topics", resp)
+	}
+
+	result.Code = resp.StatusCode()
+	return result, nil
+}
+
+// ----------- Loggers ---------
+
+//Scopes a log level change applies to
+const (
+	// LogScopeWorker changes the level only on the worker receiving the request
+	LogScopeWorker = "worker"
+	// LogScopeCluster changes the level on every worker of the cluster (kafka-connect 3.7+)
+	LogScopeCluster = "cluster"
+)
+
+//LoggerRequest is generic request used when interacting with logger endpoint
+type LoggerRequest struct {
+	Name string
+}
+
+//SetLogLevelRequest is request used to change the level of a logger
+type SetLogLevelRequest struct {
+	LoggerRequest
+	Level string
+	// Scope is either LogScopeWorker or LogScopeCluster, worker by default
+	Scope string
+}
+
+//LoggerLevel is the level of a logger
+type LoggerLevel struct {
+	Level string `json:"level"`
+	// LastModified is the time of the last change in milliseconds since epoch, nil if never modified
+	LastModified *int64 `json:"last_modified"`
+}
+
+//GetLoggersResponse is response returned by get loggers endpoint
+type GetLoggersResponse struct {
+	EmptyResponse
+	Loggers map[string]LoggerLevel
+}
+
+//GetLoggerResponse is response returned by get logger endpoint
+type GetLoggerResponse struct {
+	EmptyResponse
+	LoggerLevel
+}
+
+//SetLogLevelResponse is response returned by set log level endpoint
+type SetLogLevelResponse struct {
+	EmptyResponse
+	// AffectedLoggers is only returned for worker scope
+	AffectedLoggers []string
+}
+
+//GetLoggers return level of every logger explicitly configured on the worker
+func (c *baseClient) GetLoggers() (GetLoggersResponse, error) {
+	return c.GetLoggersContext(context.Background())
+}
+
+//GetLoggersContext is GetLoggers with a context, which is used to cancel the request
+func (c *baseClient) GetLoggersContext(ctx context.Context) (GetLoggersResponse, error) {
+	result := GetLoggersResponse{}
+	var loggers map[string]LoggerLevel
+
+	request := c.newRequest(ctx, "GetLoggers", "").
+		SetResult(&loggers)
+	resp, err := c.execute(request, resty.MethodGet, "admin/loggers")
+	if err != nil {
+		return GetLoggersResponse{}, err
+	}
+	if resp.StatusCode() >= 400 {
+		return GetLoggersResponse{}, newAPIError("Get loggers", resp)
 	}
 
 	result.Code = resp.StatusCode()
+	result.Loggers = loggers
+	return result, nil
+}
 
+//GetLogger return level of a logger on the worker
+func (c *baseClient) GetLogger(req LoggerRequest) (GetLoggerResponse, error) {
+	return c.GetLoggerContext(context.Background(), req)
+}
+
+//GetLoggerContext is GetLogger with a context, which is used to cancel the request
+func (c *baseClient) GetLoggerContext(ctx context.Context, req LoggerRequest) (GetLoggerResponse, error) {
+	result := GetLoggerResponse{}
+
+	request := c.newRequest(ctx, "GetLogger", "").
+		SetResult(&result.LoggerLevel).
+		SetPathParams(map[string]string{"name": req.Name})
+	resp, err := c.execute(request, resty.MethodGet, "admin/loggers/{name}")
+	if err != nil {
+		return GetLoggerResponse{}, err
+	}
+	if resp.StatusCode() >= 400 {
+		return GetLoggerResponse{}, newAPIError("Get logger", resp)
+	}
+
+	result.Code = resp.StatusCode()
+	return result, nil
+}
+
+//SetLogLevel change level of a logger and its children
+func (c *baseClient) SetLogLevel(req SetLogLevelRequest) (SetLogLevelResponse, error) {
+	return c.SetLogLevelContext(context.Background(), req)
+}
+
+//SetLogLevelContext is SetLogLevel with a context, which is used to cancel the request
+func (c *baseClient) SetLogLevelContext(ctx context.Context, req SetLogLevelRequest) (SetLogLevelResponse, error) {
+	result := SetLogLevelResponse{}
+	var affectedLoggers []string
+
+	request := c.newRequest(ctx, "SetLogLevel", "").
+		SetBody(map[string]string{"level": req.Level}).
+		SetResult(&affectedLoggers).
+		SetPathParams(map[string]string{"name": req.Name})
+	if req.Scope != "" {
+		request.SetQueryParam("scope", req.Scope)
+	}
+
+	resp, err := c.execute(request, resty.MethodPut, "admin/loggers/{name}")
+	if err != nil {
+		return SetLogLevelResponse{}, err
+	}
+	if resp.StatusCode() >= 400 {
+		return SetLogLevelResponse{}, newAPIError("Set log level", resp)
+	}
+
+	result.Code = resp.StatusCode()
+	result.AffectedLoggers = affectedLoggers
 	return result, nil
 }
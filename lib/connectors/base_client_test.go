@@ -3,9 +3,11 @@
 package connectors
 
 import (
+	"encoding/json"
 	"net/http"
 	"testing"
 
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"gopkg.in/jarcoal/httpmock.v1"
 )
@@ -47,3 +49,308 @@ func Test_Get(t *testing.T) {
 	assert.Equal(t, "test", connector.Name)
 	assert.NoError(t, err)
 }
+
+func Test_ValidateConnectorConfig(t *testing.T) {
+	client := newBaseClient("http://randomurl")
+	// mock HTTP response
+	{
+		typedClient := client.(*baseClient)
+		httpmock.Reset()
+		httpmock.ActivateNonDefault(typedClient.restClient.GetClient())
+		defer httpmock.DeactivateAndReset()
+
+		myresponder := func(req *http.Request) (*http.Response, error) {
+			var body map[string]interface{}
+			if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+				return httpmock.NewStringResponse(400, err.Error()), nil
+			}
+			jsonresp, _ := httpmock.NewJsonResponse(200, map[string]interface{}{
+				"name":        "FileStreamSinkConnector",
+				"error_count": 1,
+				"groups":      []string{"Common"},
+				"configs": []interface{}{
+					map[string]interface{}{
+						"definition": map[string]interface{}{"name": "connector.class", "type": "STRING", "required": true},
+						"value":      map[string]interface{}{"name": "connector.class", "value": body["connector.class"], "errors": []string{}, "visible": true},
+					},
+					map[string]interface{}{
+						"definition": map[string]interface{}{"name": "topics", "type": "LIST"},
+						"value":      map[string]interface{}{"name": "topics", "value": nil, "recommended_values": []string{"a", "b"}, "errors": []string{"missing topics"}, "visible": true},
+					},
+				},
+			})
+			return jsonresp, nil
+		}
+
+		httpmock.RegisterResponder("PUT", "http://randomurl/connector-plugins/FileStreamSinkConnector/config/validate", myresponder)
+	}
+
+	//Act
+	resp, err := client.ValidateConnectorConfig(ValidateConnectorConfigRequest{
+		Class:  "FileStreamSinkConnector",
+		Config: map[string]interface{}{"file": "/tmp/test"},
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, 200, resp.Code)
+	assert.Equal(t, 1, resp.ErrorCount)
+	assert.Equal(t, "FileStreamSinkConnector", *resp.Configs[0].Value.Value)
+	assert.Nil(t, resp.Configs[1].Value.Value)
+	assert.Equal(t, []string{"a", "b"}, resp.Configs[1].Value.RecommendedValues)
+	assert.Equal(t, map[string][]string{"topics": {"missing topics"}}, resp.FieldErrors())
+}
+
+func Test_AlterConnectorOffsets_Sink(t *testing.T) {
+	client := newBaseClient("http://randomurl")
+	var received map[string]interface{}
+	// mock HTTP response
+	{
+		typedClient := client.(*baseClient)
+		httpmock.Reset()
+		httpmock.ActivateNonDefault(typedClient.restClient.GetClient())
+		defer httpmock.DeactivateAndReset()
+
+		myresponder := func(req *http.Request) (*http.Response, error) {
+			_ = json.NewDecoder(req.Body).Decode(&received)
+			return httpmock.NewJsonResponse(200, map[string]interface{}{"message": "offsets altered"})
+		}
+
+		httpmock.RegisterResponder("PATCH", "http://randomurl/connectors/test/offsets", myresponder)
+	}
+
+	//Act
+	resp, err := client.AlterConnectorOffsets(AlterConnectorOffsetsRequest{
+		ConnectorRequest: ConnectorRequest{Name: "test"},
+		SinkOffsets:      []SinkOffset{{Topic: "topic", Partition: 1, Offset: 42}},
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, 200, resp.Code)
+	assert.Equal(t, "offsets altered", resp.Message)
+	assert.Equal(t, map[string]interface{}{
+		"offsets": []interface{}{
+			map[string]interface{}{
+				"partition": map[string]interface{}{"kafka_topic": "topic", "kafka_partition": float64(1)},
+				"offset":    map[string]interface{}{"kafka_offset": float64(42)},
+			},
+		},
+	}, received)
+}
+
+func Test_GetConnectorOffsets_Sink(t *testing.T) {
+	client := newBaseClient("http://randomurl")
+	// mock HTTP response
+	{
+		typedClient := client.(*baseClient)
+		httpmock.Reset()
+		httpmock.ActivateNonDefault(typedClient.restClient.GetClient())
+		defer httpmock.DeactivateAndReset()
+
+		myresponder := func(req *http.Request) (*http.Response, error) {
+			resp := httpmock.NewStringResponse(200, `{"offsets":[{"partition":{"kafka_topic":"topic","kafka_partition":2},"offset":{"kafka_offset":4}}]}`)
+			resp.Header.Set("Content-Type", "application/json")
+			return resp, nil
+		}
+
+		httpmock.RegisterResponder("GET", "http://randomurl/connectors/test/offsets", myresponder)
+	}
+
+	//Act
+	resp, err := client.GetConnectorOffsets(ConnectorRequest{Name: "test"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []SinkOffset{{Topic: "topic", Partition: 2, Offset: 4}}, resp.SinkOffsets())
+}
+
+func Test_GetAllExpanded(t *testing.T) {
+	client := newBaseClient("http://randomurl")
+	// mock HTTP response
+	{
+		typedClient := client.(*baseClient)
+		httpmock.Reset()
+		httpmock.ActivateNonDefault(typedClient.restClient.GetClient())
+		defer httpmock.DeactivateAndReset()
+
+		myresponder := func(req *http.Request) (*http.Response, error) {
+			if len(req.URL.Query()["expand"]) != 2 {
+				return httpmock.NewJsonResponse(200, []string{"test"})
+			}
+			return httpmock.NewJsonResponse(200, map[string]interface{}{
+				"test": map[string]interface{}{
+					"info":   map[string]interface{}{"name": "test", "config": map[string]interface{}{"name": "test"}, "tasks": []interface{}{}},
+					"status": map[string]interface{}{"name": "test", "connector": map[string]interface{}{"state": "RUNNING"}, "tasks": []interface{}{}},
+				},
+			})
+		}
+
+		httpmock.RegisterResponder("GET", "http://randomurl/connectors", myresponder)
+	}
+
+	//Act
+	resp, err := client.GetAllExpanded(true, true)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 200, resp.Code)
+	assert.Equal(t, "test", resp.Connectors["test"].Info.Config["name"])
+	assert.Equal(t, StateRunning, resp.Connectors["test"].Status.ConnectorStatus["state"])
+}
+
+func Test_CreateConnector_When_Bad_Config(t *testing.T) {
+	client := newBaseClient("http://randomurl")
+	// mock HTTP response
+	{
+		typedClient := client.(*baseClient)
+		httpmock.Reset()
+		httpmock.ActivateNonDefault(typedClient.restClient.GetClient())
+		defer httpmock.DeactivateAndReset()
+
+		httpmock.RegisterResponder("POST", "http://randomurl/connectors",
+			httpmock.NewJsonResponderOrPanic(400, ErrorResponse{ErrorCode: 400, Message: "Connector configuration is invalid"}))
+	}
+
+	//Act
+	_, err := client.CreateConnector(CreateConnectorRequest{ConnectorRequest: ConnectorRequest{Name: "test"}})
+
+	assert.True(t, errors.Is(err, ErrBadConfig))
+	assert.False(t, errors.Is(err, ErrNotFound))
+	var apiErr *APIError
+	if assert.True(t, errors.As(err, &apiErr)) {
+		assert.Equal(t, "Create connector", apiErr.Operation)
+		assert.Equal(t, 400, apiErr.StatusCode)
+		assert.Equal(t, 400, apiErr.ErrorCode)
+		assert.Equal(t, "Connector configuration is invalid", apiErr.Message)
+	}
+}
+
+func Test_GetConnector_When_Not_Found(t *testing.T) {
+	client := newBaseClient("http://randomurl")
+	// mock HTTP response
+	{
+		typedClient := client.(*baseClient)
+		httpmock.Reset()
+		httpmock.ActivateNonDefault(typedClient.restClient.GetClient())
+		defer httpmock.DeactivateAndReset()
+
+		httpmock.RegisterResponder("GET", "http://randomurl/connectors/test",
+			httpmock.NewStringResponder(404, `{"error_code":404,"message":"Connector test not found"}`))
+	}
+
+	//Act
+	resp, err := client.GetConnector(ConnectorRequest{Name: "test"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, 404, resp.Code)
+
+	client.SetNotFoundAsError(true)
+	_, err = client.GetConnector(ConnectorRequest{Name: "test"})
+
+	assert.True(t, errors.Is(err, ErrNotFound))
+	var apiErr *APIError
+	if assert.True(t, errors.As(err, &apiErr)) {
+		// body is parsed even without json content type
+		assert.Equal(t, "Connector test not found", apiErr.Message)
+	}
+}
+
+// baseClientCalls calls every method of BaseClient sending requests, by name of the operation
+var baseClientCalls = []struct {
+	operation string
+	call      func(client BaseClient) error
+}{
+	{"GetWorkerInfo", func(c BaseClient) error { _, err := c.GetWorkerInfo(); return err }},
+	{"GetAll", func(c BaseClient) error { _, err := c.GetAll(); return err }},
+	{"GetAllExpanded", func(c BaseClient) error { _, err := c.GetAllExpanded(true, true); return err }},
+	{"GetConnector", func(c BaseClient) error { _, err := c.GetConnector(ConnectorRequest{Name: "test"}); return err }},
+	{"CreateConnector", func(c BaseClient) error {
+		_, err := c.CreateConnector(CreateConnectorRequest{ConnectorRequest: ConnectorRequest{Name: "test"}})
+		return err
+	}},
+	{"UpdateConnector", func(c BaseClient) error {
+		_, err := c.UpdateConnector(CreateConnectorRequest{ConnectorRequest: ConnectorRequest{Name: "test"}})
+		return err
+	}},
+	{"DeleteConnector", func(c BaseClient) error { _, err := c.DeleteConnector(ConnectorRequest{Name: "test"}); return err }},
+	{"GetConnectorConfig", func(c BaseClient) error {
+		_, err := c.GetConnectorConfig(ConnectorRequest{Name: "test"})
+		return err
+	}},
+	{"GetConnectorStatus", func(c BaseClient) error {
+		_, err := c.GetConnectorStatus(ConnectorRequest{Name: "test"})
+		return err
+	}},
+	{"RestartConnector", func(c BaseClient) error { _, err := c.RestartConnector(ConnectorRequest{Name: "test"}); return err }},
+	{"RestartConnectorWithOptions", func(c BaseClient) error {
+		_, err := c.RestartConnectorWithOptions(RestartConnectorRequest{ConnectorRequest: ConnectorRequest{Name: "test"}, IncludeTasks: true})
+		return err
+	}},
+	{"PauseConnector", func(c BaseClient) error { _, err := c.PauseConnector(ConnectorRequest{Name: "test"}); return err }},
+	{"ResumeConnector", func(c BaseClient) error { _, err := c.ResumeConnector(ConnectorRequest{Name: "test"}); return err }},
+	{"StopConnector", func(c BaseClient) error { _, err := c.StopConnector(ConnectorRequest{Name: "test"}); return err }},
+	{"GetAllTasks", func(c BaseClient) error { _, err := c.GetAllTasks(ConnectorRequest{Name: "test"}); return err }},
+	{"GetTaskStatus", func(c BaseClient) error { _, err := c.GetTaskStatus(TaskRequest{Connector: "test"}); return err }},
+	{"RestartTask", func(c BaseClient) error { _, err := c.RestartTask(TaskRequest{Connector: "test"}); return err }},
+	{"GetConnectorPlugins", func(c BaseClient) error { _, err := c.GetConnectorPlugins(); return err }},
+	{"ValidateConnectorConfig", func(c BaseClient) error {
+		_, err := c.ValidateConnectorConfig(ValidateConnectorConfigRequest{Class: "FileStreamSinkConnector"})
+		return err
+	}},
+	{"GetConnectorOffsets", func(c BaseClient) error {
+		_, err := c.GetConnectorOffsets(ConnectorRequest{Name: "test"})
+		return err
+	}},
+	{"AlterConnectorOffsets", func(c BaseClient) error {
+		_, err := c.AlterConnectorOffsets(AlterConnectorOffsetsRequest{
+			ConnectorRequest: ConnectorRequest{Name: "test"},
+			SinkOffsets:      []SinkOffset{{Topic: "topic", Partition: 0, Offset: 1}},
+		})
+		return err
+	}},
+	{"ResetConnectorOffsets", func(c BaseClient) error {
+		_, err := c.ResetConnectorOffsets(ConnectorRequest{Name: "test"})
+		return err
+	}},
+	{"GetConnectorTopics", func(c BaseClient) error {
+		_, err := c.GetConnectorTopics(ConnectorRequest{Name: "test"})
+		return err
+	}},
+	{"ResetConnectorTopics", func(c BaseClient) error {
+		_, err := c.ResetConnectorTopics(ConnectorRequest{Name: "test"})
+		return err
+	}},
+	{"GetLoggers", func(c BaseClient) error { _, err := c.GetLoggers(); return err }},
+	{"GetLogger", func(c BaseClient) error { _, err := c.GetLogger(LoggerRequest{Name: "root"}); return err }},
+	{"SetLogLevel", func(c BaseClient) error {
+		_, err := c.SetLogLevel(SetLogLevelRequest{LoggerRequest: LoggerRequest{Name: "root"}, Level: "DEBUG"})
+		return err
+	}},
+}
+
+func Test_BaseClient_Requests_Go_Through_Execute(t *testing.T) {
+	var operations []string
+	client := newBaseClient("http://randomurl")
+	client.AddInterceptor(InterceptorFuncs{Before: func(req *RequestInfo) error {
+		operations = append(operations, req.Operation)
+		return nil
+	}})
+	// mock HTTP response
+	{
+		typedClient := client.(*baseClient)
+		httpmock.Reset()
+		httpmock.ActivateNonDefault(typedClient.restClient.GetClient())
+		defer httpmock.DeactivateAndReset()
+
+		httpmock.RegisterNoResponder(httpmock.NewStringResponder(200, ""))
+	}
+
+	for _, test := range baseClientCalls {
+		t.Run(test.operation, func(t *testing.T) {
+			operations = nil
+
+			//Act
+			err := test.call(client)
+
+			assert.NoError(t, err)
+			assert.Equal(t, []string{test.operation}, operations)
+		})
+	}
+}
@@ -21,6 +21,15 @@ func TestHealthz(t *testing.T) {
 	assert.Equal(t, 200, resp.StatusCode)
 }
 
+func TestGetWorkerInfo(t *testing.T) {
+	client := NewClient(hostConnect)
+	resp, err := client.GetWorkerInfo()
+
+	assert.Nil(t, err)
+	assert.Equal(t, 200, resp.Code)
+	assert.NotEmpty(t, resp.Version)
+}
+
 func TestCreateConnector(t *testing.T) {
 	client := NewClient(hostConnect)
 	resp, err := client.CreateConnector(
@@ -0,0 +1,179 @@
+package connectors
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+//DeployAction is what a deployment did to a connector
+type DeployAction string
+
+const (
+	DeployCreated   DeployAction = "created"
+	DeployUpdated   DeployAction = "updated"
+	DeployUnchanged DeployAction = "unchanged"
+	// DeployDeleted is only done by reconciliation, to connectors missing from the desired state, see Reconcile
+	DeployDeleted DeployAction = "deleted"
+)
+
+//ConfigChange is a difference between the deployed config of a connector and the one to deploy
+type ConfigChange struct {
+	Key string `json:"key"`
+	// Old is nil when the key is added
+	Old interface{} `json:"old,omitempty"`
+	// New is nil when the key is removed
+	New interface{} `json:"new,omitempty"`
+}
+
+//String describes the change, values of secrets are redacted
+func (c ConfigChange) String() string {
+	old, new := c.Old, c.New
+	if isSecretKey(c.Key) {
+		old, new = redacted, redacted
+	}
+	switch {
+	case c.Old == nil:
+		return fmt.Sprintf("+%s=%v", c.Key, new)
+	case c.New == nil:
+		return fmt.Sprintf("-%s=%v", c.Key, old)
+	default:
+		return fmt.Sprintf("~%s=%v->%v", c.Key, old, new)
+	}
+}
+
+//ConfigDiff lists changes between two configs, sorted by key
+type ConfigDiff []ConfigChange
+
+//String describes every change, values of secrets are redacted
+func (d ConfigDiff) String() string {
+	changes := make([]string, len(d))
+	for i, change := range d {
+		changes[i] = change.String()
+	}
+	return strings.Join(changes, " ")
+}
+
+// diffConfig compares a config to deploy with the deployed one, which is nil if the connector does not exist
+// Values are compared the same way as isConfigUpToDate does, the name of the connector is left out
+func diffConfig(config map[string]interface{}, deployedConfig map[string]interface{}) ConfigDiff {
+	diff := ConfigDiff{}
+	for key, value := range config {
+		if key == "name" {
+			continue
+		}
+		deployed, ok := deployedConfig[key]
+		if !ok {
+			diff = append(diff, ConfigChange{Key: key, New: value})
+		} else if convertConfigValueToString(deployed) != convertConfigValueToString(value) {
+			diff = append(diff, ConfigChange{Key: key, Old: deployed, New: value})
+		}
+	}
+	for key, deployed := range deployedConfig {
+		if _, ok := config[key]; !ok && key != "name" {
+			diff = append(diff, ConfigChange{Key: key, Old: deployed})
+		}
+	}
+	sort.Slice(diff, func(i, j int) bool { return diff[i].Key < diff[j].Key })
+	return diff
+}
+
+//DeployResult tells what a deployment did to a connector
+type DeployResult struct {
+	Name   string
+	Action DeployAction
+	// Diff holds changes applied to the config, every key when the connector was created
+	Diff     ConfigDiff
+	Duration time.Duration
+	// ConnectorState and Tasks are the status of the connector once deployed, empty if it could not be fetched
+	ConnectorState string
+	Tasks          []TaskStatus
+	// Err is the reason the deployment failed, if it did, an *UnhealthyDeployError if a health check failed
+	Err error
+	// RolledBack tells whether a health check failed and the previous config was restored, or the created connector deleted
+	// ConnectorState and Tasks, with their trace, are then the status which failed, see SetHealthCheck
+	RolledBack bool
+	// RollbackErr is the reason the rollback failed, if it did
+	RollbackErr error
+}
+
+//DeployConnectorWithResult is DeployConnector, it also tells what was done and the status of the connector once deployed
+func (c *highLevelClient) DeployConnectorWithResult(req CreateConnectorRequest) (DeployResult, error) {
+	return c.DeployConnectorWithResultContext(context.Background(), req)
+}
+
+//DeployConnectorWithResultContext is DeployConnectorWithResult with a context, which is used to cancel requests and synchronous waits
+func (c *highLevelClient) DeployConnectorWithResultContext(ctx context.Context, req CreateConnectorRequest) (result DeployResult, err error) {
+	ctx, op := c.startOperation(ctx, "DeployConnector", req.Name)
+	defer func() { op.end(err) }()
+
+	start := time.Now()
+	result, err = c.deployConnector(ctx, req)
+	// unless the health check already fetched it
+	if err == nil && result.ConnectorState == "" {
+		c.setFinalState(ctx, &result)
+	}
+	result.Duration = time.Since(start)
+	result.Err = err
+	return result, err
+}
+
+// setFinalState sets the status of a deployed connector, a failure is only logged as the deployment itself succeeded
+func (c *highLevelClient) setFinalState(ctx context.Context, result *DeployResult) {
+	status, err := c.GetConnectorStatusContext(ctx, ConnectorRequest{Name: result.Name})
+	if err != nil || status.Code >= 400 {
+		c.log().Warn("could not get status of deployed connector", "connector", result.Name, "status", status.Code, "error", err)
+		return
+	}
+	result.ConnectorState = status.ConnectorStatus["state"]
+	result.Tasks = status.TasksStatus
+}
+
+//DeployMultipleConnectorWithResult is DeployMultipleConnector, it also tells what was done to each connector, in the given order
+//Connectors which were not deployed because ctx was cancelled have its error
+func (c *highLevelClient) DeployMultipleConnectorWithResult(connectors []CreateConnectorRequest) ([]DeployResult, error) {
+	return c.DeployMultipleConnectorWithResultContext(context.Background(), connectors)
+}
+
+//DeployMultipleConnectorWithResultContext is DeployMultipleConnectorWithResult with a context, which is used to cancel requests and synchronous waits
+func (c *highLevelClient) DeployMultipleConnectorWithResultContext(ctx context.Context, connectors []CreateConnectorRequest) (results []DeployResult, err error) {
+	ctx, op := c.startOperation(ctx, "DeployMultipleConnector", "")
+	defer func() { op.end(err) }()
+
+	start := time.Now()
+	results = make([]DeployResult, len(connectors))
+	started := make([]bool, len(connectors))
+	lock := sync.Mutex{}
+
+	err = c.deployEach(ctx, connectors, true,
+		func(i int, req CreateConnectorRequest) error {
+			result, err := c.DeployConnectorWithResultContext(ctx, req)
+			lock.Lock()
+			defer lock.Unlock()
+			results[i], started[i] = result, true
+			return err
+		},
+		func(i int, existing ExpandedConnector) {
+			lock.Lock()
+			defer lock.Unlock()
+			results[i] = DeployResult{
+				Name:           existing.Info.Name,
+				Action:         DeployUnchanged,
+				Diff:           ConfigDiff{},
+				Duration:       time.Since(start),
+				ConnectorState: existing.Status.ConnectorStatus["state"],
+				Tasks:          existing.Status.TasksStatus,
+			}
+			started[i] = true
+		})
+
+	for i, connector := range connectors {
+		if !started[i] {
+			results[i] = DeployResult{Name: connector.Name, Err: ctx.Err()}
+		}
+	}
+	return results, err
+}
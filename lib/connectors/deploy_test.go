@@ -0,0 +1,126 @@
+//go:build !integration
+
+package connectors
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"bou.ke/monkey"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_diffConfig(t *testing.T) {
+	diff := diffConfig(
+		map[string]interface{}{"param1": 3, "param2": "abc", "param4": "new", "db.password": "new-secret"},
+		map[string]interface{}{"name": "test1", "param1": "2", "param2": "abc", "param3": "old", "db.password": "old-secret"},
+	)
+
+	assert.Equal(t, ConfigDiff{
+		{Key: "db.password", Old: "old-secret", New: "new-secret"},
+		{Key: "param1", Old: "2", New: 3},
+		{Key: "param3", Old: "old"},
+		{Key: "param4", New: "new"},
+	}, diff)
+	assert.Equal(t, "~db.password=[REDACTED]->[REDACTED] ~param1=2->3 -param3=old +param4=new", diff.String())
+}
+
+func Test_DeployConnectorWithResult_Updated(t *testing.T) {
+	configOnline := map[string]interface{}{
+		"name":   "test1",
+		"param1": 2,
+	}
+
+	mockBaseClient := &MockBaseClient{}
+	mockBaseClient.On("GetConnectorContext", mock.Anything, mock.Anything).
+		Return(ConnectorResponse{Name: "test1", Config: configOnline}, nil)
+	mockBaseClient.On("GetConnectorConfigContext", mock.Anything, mock.Anything).
+		Return(GetConnectorConfigResponse{Config: configOnline}, nil).Once()
+	mockBaseClient.On("UpdateConnectorContext", mock.Anything, mock.Anything).
+		Return(ConnectorResponse{}, nil)
+	mockBaseClient.On("GetConnectorConfigContext", mock.Anything, mock.Anything).
+		Return(GetConnectorConfigResponse{Config: map[string]interface{}{"name": "test1", "param1": 3}}, nil).Once()
+	mockBaseClient.On("GetConnectorStatusContext", mock.Anything, ConnectorRequest{Name: "test1"}).
+		Return(GetConnectorStatusResponse{
+			ConnectorStatus: map[string]string{"state": "RUNNING"},
+			TasksStatus:     []TaskStatus{{ID: 0, State: "RUNNING"}},
+		}, nil)
+
+	client := &highLevelClient{client: mockBaseClient}
+	result, err := client.DeployConnectorWithResult(CreateConnectorRequest{
+		ConnectorRequest: ConnectorRequest{"test1"},
+		Config:           map[string]interface{}{"param1": 3},
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "test1", result.Name)
+	assert.Equal(t, DeployUpdated, result.Action)
+	assert.Equal(t, ConfigDiff{{Key: "param1", Old: 2, New: 3}}, result.Diff)
+	assert.Equal(t, "RUNNING", result.ConnectorState)
+	assert.Equal(t, []TaskStatus{{ID: 0, State: "RUNNING"}}, result.Tasks)
+	assert.NoError(t, result.Err)
+	mockBaseClient.AssertExpectations(t)
+}
+
+func Test_DeployConnectorWithResult_Error(t *testing.T) {
+	mockBaseClient := &MockBaseClient{}
+	mockBaseClient.On("GetConnectorContext", mock.Anything, mock.Anything).
+		Return(ConnectorResponse{}, errors.New("random error"))
+
+	client := &highLevelClient{client: mockBaseClient}
+	result, err := client.DeployConnectorWithResult(CreateConnectorRequest{
+		ConnectorRequest: ConnectorRequest{"test1"},
+		Config:           map[string]interface{}{"param1": 3},
+	})
+
+	assert.Error(t, err)
+	assert.Equal(t, err, result.Err)
+	assert.Equal(t, "", result.ConnectorState)
+	mockBaseClient.AssertNotCalled(t, "GetConnectorStatusContext", mock.Anything, mock.Anything)
+}
+
+func Test_DeployMultipleConnectorWithResult(t *testing.T) {
+	mockBaseClient := &MockBaseClient{}
+	mockBaseClient.On("GetAllExpandedContext", mock.Anything, true, true).
+		Return(GetAllExpandedConnectorsResponse{Connectors: map[string]ExpandedConnector{
+			"test1": {
+				Info: ConnectorResponse{Name: "test1", Config: map[string]interface{}{"name": "test1", "param1": "2"}},
+				Status: GetConnectorStatusResponse{
+					ConnectorStatus: map[string]string{"state": "PAUSED"},
+					TasksStatus:     []TaskStatus{{ID: 0, State: "PAUSED"}},
+				},
+			},
+		}}, nil)
+
+	client := &highLevelClient{client: mockBaseClient, maxParallelRequest: 2}
+
+	// Don't want to mock every baseClient call, so I am going the lazy way.
+	patch := monkey.PatchInstanceMethod(reflect.TypeOf(client), "DeployConnectorWithResultContext", func(_ *highLevelClient, _ context.Context, req CreateConnectorRequest) (DeployResult, error) {
+		if req.Name == "test3" {
+			err := errors.New("random error")
+			return DeployResult{Name: req.Name, Err: err}, err
+		}
+		return DeployResult{Name: req.Name, Action: DeployCreated, ConnectorState: "RUNNING"}, nil
+	})
+	defer patch.Unpatch()
+
+	results, err := client.DeployMultipleConnectorWithResult([]CreateConnectorRequest{
+		{ConnectorRequest: ConnectorRequest{Name: "test1"}, Config: map[string]interface{}{"param1": 2}},
+		{ConnectorRequest: ConnectorRequest{Name: "test2"}, Config: map[string]interface{}{"param1": 2}},
+		{ConnectorRequest: ConnectorRequest{Name: "test3"}, Config: map[string]interface{}{"param1": 2}},
+	})
+
+	assert.Error(t, err)
+	if assert.Len(t, results, 3) {
+		assert.Equal(t, "test1", results[0].Name)
+		assert.Equal(t, DeployUnchanged, results[0].Action)
+		assert.Equal(t, "PAUSED", results[0].ConnectorState)
+		assert.Equal(t, []TaskStatus{{ID: 0, State: "PAUSED"}}, results[0].Tasks)
+		assert.Equal(t, DeployResult{Name: "test2", Action: DeployCreated, ConnectorState: "RUNNING"}, results[1])
+		assert.Equal(t, "test3", results[2].Name)
+		assert.Error(t, results[2].Err)
+	}
+}
@@ -0,0 +1,78 @@
+package connectors
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"gopkg.in/resty.v1"
+)
+
+// Kinds of failure reported by kafka-connect, use errors.Is to check an error against them
+// and errors.As with *APIError to get details
+var (
+	//ErrNotFound is returned when the connector, task or logger does not exist
+	//Get endpoints only return it once SetNotFoundAsError is enabled, see EmptyResponse.Code otherwise
+	ErrNotFound = errors.New("not found")
+	//ErrConflict is returned when kafka-connect is rebalancing and retrying did not help, or when a connector already exists
+	ErrConflict = errors.New("conflict, a rebalance may be in progress")
+	//ErrBadConfig is returned when a request, most often a connector config, is rejected
+	ErrBadConfig = errors.New("bad config")
+	//ErrUnauthorized is returned when credentials are missing or not allowed to call the endpoint
+	ErrUnauthorized = errors.New("unauthorized")
+	//ErrServer is returned when kafka-connect failed to handle the request
+	ErrServer = errors.New("server error")
+)
+
+//APIError is returned when kafka-connect answered with an error status
+type APIError struct {
+	// Operation is the name of the client method which failed, e.g. "Create connector"
+	Operation  string
+	StatusCode int
+	// ErrorResponse is the error parsed from the response, empty if the body was not the expected json
+	ErrorResponse
+	// Body is the raw response
+	Body string
+}
+
+func (err *APIError) Error() string {
+	return fmt.Sprintf("%s : %v", err.Operation, err.Body)
+}
+
+//Is makes errors.Is work with ErrNotFound, ErrConflict, ErrBadConfig, ErrUnauthorized and ErrServer
+func (err *APIError) Is(target error) bool {
+	switch target {
+	case ErrNotFound:
+		return err.StatusCode == 404
+	case ErrConflict:
+		return err.StatusCode == 409
+	case ErrBadConfig:
+		return err.StatusCode == 400 || err.StatusCode == 422
+	case ErrUnauthorized:
+		return err.StatusCode == 401 || err.StatusCode == 403
+	case ErrServer:
+		return err.StatusCode >= 500
+	default:
+		return false
+	}
+}
+
+// newAPIError builds the error returned when resp has an error status
+func newAPIError(operation string, resp *resty.Response) error {
+	err := &APIError{Operation: operation, StatusCode: resp.StatusCode(), Body: resp.String()}
+	if parsed, ok := resp.Error().(*ErrorResponse); ok && parsed != nil && parsed.ErrorCode != 0 {
+		err.ErrorResponse = *parsed
+	} else {
+		// resty only parses errors of responses with a json content type
+		_ = json.Unmarshal(resp.Body(), &err.ErrorResponse)
+	}
+	return err
+}
+
+// isNotFound tells whether a get request found nothing, whatever SetNotFoundAsError is
+func isNotFound(code int, err error) bool {
+	if err != nil {
+		return errors.Is(err, ErrNotFound)
+	}
+	return code == 404
+}
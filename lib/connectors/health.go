@@ -0,0 +1,230 @@
+package connectors
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+//ErrUnhealthyDeploy is returned by health-gated deploys when a connector or one of its tasks failed once deployed
+//Use errors.As with *UnhealthyDeployError to get details
+var ErrUnhealthyDeploy = errors.New("connector unhealthy after deploy")
+
+//UnhealthyDeployError is returned by health-gated deploys when a connector or one of its tasks failed within the settle window
+type UnhealthyDeployError struct {
+	Connector string
+	// State is the state of the connector when the failure was observed
+	State string
+	// FailedTasks are the tasks which failed, with their trace
+	FailedTasks []TaskStatus
+	// RolledBack tells whether the previous config was restored, or the created connector deleted
+	RolledBack bool
+	// RollbackErr is the reason the rollback failed, if it did
+	RollbackErr error
+}
+
+func (err *UnhealthyDeployError) Error() string {
+	failures := []string{}
+	if err.State == StateFailed {
+		failures = append(failures, "connector "+StateFailed)
+	}
+	for _, task := range err.FailedTasks {
+		failures = append(failures, fmt.Sprintf("task %d %s", task.ID, task.State))
+	}
+	msg := fmt.Sprintf("%v %v: %s", ErrUnhealthyDeploy, err.Connector, strings.Join(failures, ", "))
+	if err.RolledBack {
+		return msg + ", rolled back"
+	}
+	if err.RollbackErr != nil {
+		return fmt.Sprintf("%s, rollback failed: %v", msg, err.RollbackErr)
+	}
+	return msg
+}
+
+//Is makes errors.Is(err, ErrUnhealthyDeploy) true
+func (err *UnhealthyDeployError) Is(target error) bool {
+	return target == ErrUnhealthyDeploy
+}
+
+//HealthCheckOptions configures health-gated deploys: once deployed, a connector is watched for a settle window,
+//and rolled back if it or one of its tasks fails. The previous config is restored, a created connector is deleted
+//Failures which were there before the deploy, on the same worker and with the same trace, do not count
+type HealthCheckOptions struct {
+	// SettleWindow is how long a deployed connector and its tasks must not fail, 0 disables health checks
+	SettleWindow time.Duration
+	// Interval is the time between two status checks, 2 seconds by default
+	Interval time.Duration
+}
+
+const defaultHealthCheckInterval = 2 * time.Second
+
+//WithHealthCheck enables health-gated deploys, see SetHealthCheck
+func WithHealthCheck(options HealthCheckOptions) Option {
+	return func(o *clientOptions) error {
+		if options.SettleWindow < 0 || options.Interval < 0 {
+			return errors.Errorf("invalid health check options: %+v", options)
+		}
+		o.healthCheck = options
+		return nil
+	}
+}
+
+type healthCheckKey struct{}
+
+//ContextWithHealthCheck returns a context carrying health check settings for a single call
+//Settings are used by deploys called with this context, instead of the client ones
+func ContextWithHealthCheck(ctx context.Context, options HealthCheckOptions) context.Context {
+	return context.WithValue(ctx, healthCheckKey{}, options)
+}
+
+//SetHealthCheck enables health-gated deploys for DeployConnector, DeployMultipleConnector, Apply and Reconcile,
+//disabled by default. Use ContextWithHealthCheck to override settings for a single call
+func (c *highLevelClient) SetHealthCheck(options HealthCheckOptions) {
+	c.healthCheck = options
+}
+
+func (c *highLevelClient) healthCheckOptions(ctx context.Context) HealthCheckOptions {
+	options := c.healthCheck
+	if callOptions, ok := ctx.Value(healthCheckKey{}).(HealthCheckOptions); ok {
+		options = callOptions
+	}
+	if options.Interval <= 0 {
+		options.Interval = defaultHealthCheckInterval
+	}
+	return options
+}
+
+// updateConnector creates or updates a connector with its deploy strategy, then watches its health if enabled
+// previous is the config before the deploy, nil if the connector is created, it is restored if the connector fails
+// The state observed by the health check is set in result
+func (c *highLevelClient) updateConnector(ctx context.Context, req CreateConnectorRequest, previous map[string]interface{}, result *DeployResult) error {
+	options := c.healthCheckOptions(ctx)
+	// tasks whose config did not change are not restarted, failures they had before the deploy are not its doing
+	before := GetConnectorStatusResponse{}
+	if options.SettleWindow > 0 && previous != nil {
+		before = c.statusBeforeDeploy(ctx, req.Name)
+	}
+
+	err := c.deployConfig(ctx, req, previous)
+	if err != nil {
+		return err
+	}
+	if options.SettleWindow <= 0 {
+		return nil
+	}
+
+	status, healthy, err := c.watchHealth(ctx, req.Name, before, options)
+	result.ConnectorState, result.Tasks = status.ConnectorStatus["state"], status.TasksStatus
+	if err != nil || healthy {
+		return err
+	}
+
+	unhealthy := &UnhealthyDeployError{Connector: req.Name, State: status.ConnectorStatus["state"]}
+	_, unhealthy.FailedTasks = failuresSince(before, status)
+	c.log().Warn("connector unhealthy after deploy, rolling back", "connector", req.Name,
+		"state", unhealthy.State, "failedTasks", len(unhealthy.FailedTasks))
+	unhealthy.RollbackErr = c.rollback(ctx, req.Name, previous)
+	unhealthy.RolledBack = unhealthy.RollbackErr == nil
+	result.RolledBack, result.RollbackErr = unhealthy.RolledBack, unhealthy.RollbackErr
+	return unhealthy
+}
+
+// statusBeforeDeploy returns the status of a connector about to be deployed, an empty one if it could not be fetched,
+// in which case every failure observed after the deploy counts
+func (c *highLevelClient) statusBeforeDeploy(ctx context.Context, connector string) GetConnectorStatusResponse {
+	status, err := c.GetConnectorStatusContext(ctx, ConnectorRequest{Name: connector})
+	if err != nil || status.Code >= 400 {
+		c.log().Debug("could not get status of connector before deploying", "connector", connector, "status", status.Code, "error", err)
+		return GetConnectorStatusResponse{}
+	}
+	return status
+}
+
+// watchHealth checks the status of a connector until it or one of its tasks fails, or the settle window elapses
+// Failures which were already there before the deploy are ignored, see failuresSince
+// Failing to get the status does not count as a failure, but the connector is not healthy if it was never observed
+func (c *highLevelClient) watchHealth(ctx context.Context, connector string, before GetConnectorStatusResponse, options HealthCheckOptions) (status GetConnectorStatusResponse, healthy bool, err error) {
+	ctx, op := c.startOperation(ctx, "watch health", connector)
+	defer func() { op.end(err) }()
+
+	deadline := time.Now().Add(options.SettleWindow)
+	observed := false
+	var lastErr error
+	for {
+		current, err := c.GetConnectorStatusContext(ctx, ConnectorRequest{Name: connector})
+		if err == nil && current.Code < 400 {
+			status, observed = current, true
+			if connectorFailed, failedTasks := failuresSince(before, status); connectorFailed || len(failedTasks) > 0 {
+				return status, false, nil
+			}
+		} else {
+			lastErr = err
+			c.log().Debug("could not check health of connector", "connector", connector, "status", current.Code, "error", err)
+		}
+
+		remaining := time.Until(deadline)
+		if remaining <= 0 {
+			break
+		}
+		wait := options.Interval
+		if wait > remaining {
+			wait = remaining
+		}
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return status, false, errors.Wrapf(ctx.Err(), "health check of %v interrupted", connector)
+		case <-timer.C:
+		}
+	}
+
+	if !observed {
+		if lastErr == nil {
+			lastErr = errors.New("no status received")
+		}
+		return status, false, errors.Wrapf(lastErr, "could not check health of %v", connector)
+	}
+	return status, true, nil
+}
+
+// failuresSince returns whether the connector failed, and which tasks failed, since the before status was taken
+// A failure is new unless it was there before, on the same worker and with the same trace
+func failuresSince(before GetConnectorStatusResponse, status GetConnectorStatusResponse) (connectorFailed bool, failedTasks []TaskStatus) {
+	if status.ConnectorStatus["state"] == StateFailed {
+		connectorFailed = before.ConnectorStatus["state"] != StateFailed ||
+			before.ConnectorStatus["worker_id"] != status.ConnectorStatus["worker_id"] ||
+			before.ConnectorStatus["trace"] != status.ConnectorStatus["trace"]
+	}
+
+	tasksBefore := make(map[int]TaskStatus, len(before.TasksStatus))
+	for _, task := range before.TasksStatus {
+		tasksBefore[task.ID] = task
+	}
+	for _, task := range status.TasksStatus {
+		if task.State != StateFailed {
+			continue
+		}
+		if previous, ok := tasksBefore[task.ID]; !ok || previous.State != StateFailed ||
+			previous.WorkerID != task.WorkerID || previous.Trace != task.Trace {
+			failedTasks = append(failedTasks, task)
+		}
+	}
+	return connectorFailed, failedTasks
+}
+
+// rollback restores the previous config of a connector, or deletes it if it was created
+func (c *highLevelClient) rollback(ctx context.Context, connector string, previous map[string]interface{}) (err error) {
+	ctx, op := c.startOperation(ctx, "rollback", connector)
+	defer func() { op.end(err) }()
+
+	if previous == nil {
+		_, err = c.DeleteConnectorContext(ctx, ConnectorRequest{Name: connector}, true)
+		return err
+	}
+	_, err = c.UpdateConnectorContext(ctx, CreateConnectorRequest{ConnectorRequest: ConnectorRequest{Name: connector}, Config: previous}, true)
+	return err
+}
@@ -0,0 +1,194 @@
+//go:build !integration
+
+package connectors
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var testHealthCheck = HealthCheckOptions{SettleWindow: 50 * time.Millisecond, Interval: 10 * time.Millisecond}
+
+func Test_WithHealthCheck_Invalid(t *testing.T) {
+	_, err := NewClientWithOptions("http://localhost:8083", WithHealthCheck(HealthCheckOptions{SettleWindow: -time.Second}))
+
+	assert.Error(t, err)
+}
+
+func Test_DeployConnectorWithResult_Healthy(t *testing.T) {
+	mockBaseClient := &MockBaseClient{}
+	mockBaseClient.On("GetConnectorContext", mock.Anything, mock.Anything).
+		Return(ConnectorResponse{EmptyResponse: EmptyResponse{Code: 404}}, nil)
+	mockBaseClient.On("UpdateConnectorContext", mock.Anything, mock.Anything).Return(ConnectorResponse{}, nil)
+	mockBaseClient.On("GetConnectorConfigContext", mock.Anything, mock.Anything).
+		Return(GetConnectorConfigResponse{Config: map[string]interface{}{"name": "test1", "param1": "3"}}, nil)
+	mockBaseClient.On("GetConnectorStatusContext", mock.Anything, mock.Anything).
+		Return(GetConnectorStatusResponse{
+			ConnectorStatus: map[string]string{"state": "RUNNING"},
+			TasksStatus:     []TaskStatus{{ID: 0, State: "RUNNING"}},
+		}, nil)
+
+	client := &highLevelClient{client: mockBaseClient}
+	client.SetHealthCheck(testHealthCheck)
+	result, err := client.DeployConnectorWithResult(CreateConnectorRequest{
+		ConnectorRequest: ConnectorRequest{"test1"},
+		Config:           map[string]interface{}{"param1": 3},
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, DeployCreated, result.Action)
+	assert.Equal(t, "RUNNING", result.ConnectorState)
+	assert.False(t, result.RolledBack)
+	// the status was watched during the whole settle window
+	assert.True(t, len(mockBaseClient.Calls) > 5)
+	mockBaseClient.AssertNotCalled(t, "DeleteConnectorContext", mock.Anything, mock.Anything)
+}
+
+func Test_DeployConnectorWithResult_Unhealthy_Update_Rolled_Back(t *testing.T) {
+	configOnline := map[string]interface{}{"name": "test1", "param1": "2"}
+	failed := GetConnectorStatusResponse{
+		ConnectorStatus: map[string]string{"state": "RUNNING"},
+		TasksStatus: []TaskStatus{
+			{ID: 0, State: "RUNNING"},
+			{ID: 1, State: "FAILED", Trace: "org.apache.kafka.connect.errors.ConnectException: boom"},
+		},
+	}
+
+	mockBaseClient := &MockBaseClient{}
+	mockBaseClient.On("GetConnectorContext", mock.Anything, mock.Anything).
+		Return(ConnectorResponse{Name: "test1", Config: configOnline}, nil)
+	// compared before deploying, then waited for after deploying and after rolling back
+	mockBaseClient.On("GetConnectorConfigContext", mock.Anything, mock.Anything).
+		Return(GetConnectorConfigResponse{Config: configOnline}, nil).Once()
+	mockBaseClient.On("GetConnectorConfigContext", mock.Anything, mock.Anything).
+		Return(GetConnectorConfigResponse{Config: map[string]interface{}{"name": "test1", "param1": "3"}}, nil).Once()
+	mockBaseClient.On("GetConnectorConfigContext", mock.Anything, mock.Anything).
+		Return(GetConnectorConfigResponse{Config: configOnline}, nil).Once()
+	mockBaseClient.On("UpdateConnectorContext", mock.Anything, mock.Anything).Return(ConnectorResponse{}, nil)
+	// before deploying, then once deployed
+	mockBaseClient.On("GetConnectorStatusContext", mock.Anything, mock.Anything).Return(GetConnectorStatusResponse{
+		ConnectorStatus: map[string]string{"state": "RUNNING"},
+		TasksStatus:     []TaskStatus{{ID: 0, State: "RUNNING"}, {ID: 1, State: "RUNNING"}},
+	}, nil).Once()
+	mockBaseClient.On("GetConnectorStatusContext", mock.Anything, mock.Anything).Return(failed, nil)
+
+	client := &highLevelClient{client: mockBaseClient}
+	client.SetHealthCheck(testHealthCheck)
+	result, err := client.DeployConnectorWithResult(CreateConnectorRequest{
+		ConnectorRequest: ConnectorRequest{"test1"},
+		Config:           map[string]interface{}{"param1": 3},
+	})
+
+	assert.True(t, errors.Is(err, ErrUnhealthyDeploy))
+	unhealthy := &UnhealthyDeployError{}
+	if assert.True(t, errors.As(err, &unhealthy)) {
+		assert.True(t, unhealthy.RolledBack)
+		assert.Equal(t, []TaskStatus{failed.TasksStatus[1]}, unhealthy.FailedTasks)
+	}
+	assert.Equal(t, DeployUpdated, result.Action)
+	assert.True(t, result.RolledBack)
+	assert.NoError(t, result.RollbackErr)
+	assert.Equal(t, failed.TasksStatus, result.Tasks)
+	mockBaseClient.AssertCalled(t, "UpdateConnectorContext", mock.Anything,
+		CreateConnectorRequest{ConnectorRequest: ConnectorRequest{Name: "test1"}, Config: configOnline})
+	mockBaseClient.AssertExpectations(t)
+}
+
+func Test_DeployConnectorWithResult_Failed_Before_Deploy_Healthy_After(t *testing.T) {
+	configOnline := map[string]interface{}{"name": "test1", "param1": "2"}
+	failedBefore := GetConnectorStatusResponse{
+		ConnectorStatus: map[string]string{"state": "FAILED", "worker_id": "worker1:8083", "trace": "boom"},
+		TasksStatus:     []TaskStatus{{ID: 0, State: "FAILED", WorkerID: "worker1:8083", Trace: "boom"}},
+	}
+	running := GetConnectorStatusResponse{
+		ConnectorStatus: map[string]string{"state": "RUNNING", "worker_id": "worker1:8083"},
+		TasksStatus:     []TaskStatus{{ID: 0, State: "RUNNING", WorkerID: "worker1:8083"}},
+	}
+
+	mockBaseClient := &MockBaseClient{}
+	mockBaseClient.On("GetConnectorContext", mock.Anything, mock.Anything).
+		Return(ConnectorResponse{Name: "test1", Config: configOnline}, nil)
+	mockBaseClient.On("GetConnectorConfigContext", mock.Anything, mock.Anything).
+		Return(GetConnectorConfigResponse{Config: configOnline}, nil).Once()
+	mockBaseClient.On("GetConnectorConfigContext", mock.Anything, mock.Anything).
+		Return(GetConnectorConfigResponse{Config: map[string]interface{}{"name": "test1", "param1": "3"}}, nil)
+	mockBaseClient.On("UpdateConnectorContext", mock.Anything, mock.Anything).Return(ConnectorResponse{}, nil).Once()
+	// before deploying, then still failed until kafka-connect restarts it with the fixed config
+	mockBaseClient.On("GetConnectorStatusContext", mock.Anything, mock.Anything).Return(failedBefore, nil).Times(3)
+	mockBaseClient.On("GetConnectorStatusContext", mock.Anything, mock.Anything).Return(running, nil)
+
+	client := &highLevelClient{client: mockBaseClient}
+	client.SetHealthCheck(testHealthCheck)
+	result, err := client.DeployConnectorWithResult(CreateConnectorRequest{
+		ConnectorRequest: ConnectorRequest{"test1"},
+		Config:           map[string]interface{}{"param1": 3},
+	})
+
+	assert.NoError(t, err)
+	assert.False(t, result.RolledBack)
+	assert.Equal(t, "RUNNING", result.ConnectorState)
+	assert.Equal(t, running.TasksStatus, result.Tasks)
+	// the broken config was not restored
+	mockBaseClient.AssertNumberOfCalls(t, "UpdateConnectorContext", 1)
+	mockBaseClient.AssertExpectations(t)
+}
+
+func Test_DeployConnector_Unhealthy_Create_Deleted(t *testing.T) {
+	mockBaseClient := &MockBaseClient{}
+	mockBaseClient.On("GetConnectorContext", mock.Anything, mock.Anything).
+		Return(ConnectorResponse{EmptyResponse: EmptyResponse{Code: 404}}, nil)
+	mockBaseClient.On("UpdateConnectorContext", mock.Anything, mock.Anything).Return(ConnectorResponse{}, nil)
+	mockBaseClient.On("GetConnectorConfigContext", mock.Anything, mock.Anything).
+		Return(GetConnectorConfigResponse{Config: map[string]interface{}{"name": "test1", "param1": "3"}}, nil)
+	mockBaseClient.On("GetConnectorStatusContext", mock.Anything, mock.Anything).
+		Return(GetConnectorStatusResponse{ConnectorStatus: map[string]string{"state": "FAILED", "trace": "boom"}}, nil)
+	mockBaseClient.On("DeleteConnectorContext", mock.Anything, ConnectorRequest{Name: "test1"}).Return(EmptyResponse{}, nil)
+
+	client := &highLevelClient{client: mockBaseClient}
+	ctx := ContextWithHealthCheck(context.Background(), testHealthCheck)
+	err := client.DeployConnectorContext(ctx, CreateConnectorRequest{
+		ConnectorRequest: ConnectorRequest{"test1"},
+		Config:           map[string]interface{}{"param1": 3},
+	})
+
+	assert.True(t, errors.Is(err, ErrUnhealthyDeploy))
+	assert.Contains(t, err.Error(), "connector FAILED, rolled back")
+	mockBaseClient.AssertExpectations(t)
+}
+
+func Test_failuresSince(t *testing.T) {
+	before := GetConnectorStatusResponse{
+		ConnectorStatus: map[string]string{"state": "RUNNING", "worker_id": "worker1:8083"},
+		TasksStatus: []TaskStatus{
+			{ID: 0, State: "FAILED", WorkerID: "worker1:8083", Trace: "boom"},
+			{ID: 1, State: "FAILED", WorkerID: "worker1:8083", Trace: "boom"},
+			{ID: 2, State: "FAILED", WorkerID: "worker1:8083", Trace: "boom"},
+			{ID: 3, State: "RUNNING", WorkerID: "worker1:8083"},
+		},
+	}
+	after := GetConnectorStatusResponse{
+		ConnectorStatus: map[string]string{"state": "FAILED", "worker_id": "worker1:8083", "trace": "bang"},
+		TasksStatus: []TaskStatus{
+			{ID: 0, State: "FAILED", WorkerID: "worker1:8083", Trace: "boom"},
+			{ID: 1, State: "FAILED", WorkerID: "worker2:8083", Trace: "boom"},
+			{ID: 2, State: "FAILED", WorkerID: "worker1:8083", Trace: "bang"},
+			{ID: 3, State: "FAILED", WorkerID: "worker1:8083", Trace: "bang"},
+			{ID: 4, State: "FAILED", WorkerID: "worker1:8083", Trace: "bang"},
+		},
+	}
+
+	connectorFailed, failedTasks := failuresSince(before, after)
+
+	assert.True(t, connectorFailed)
+	assert.Equal(t, after.TasksStatus[1:], failedTasks)
+
+	connectorFailed, failedTasks = failuresSince(after, after)
+
+	assert.False(t, connectorFailed)
+	assert.Empty(t, failedTasks)
+}
@@ -1,8 +1,10 @@
 package connectors
 
 import (
+	"context"
 	"crypto/tls"
 	"fmt"
+	"sort"
 	"sync"
 	"time"
 
@@ -13,40 +15,304 @@ import (
 // HighLevelClient support all function of kafka-connect API + some more features
 type HighLevelClient interface {
 	// kafka-connect api
+	GetWorkerInfo() (WorkerInfoResponse, error)
+	GetWorkerInfoContext(ctx context.Context) (WorkerInfoResponse, error)
 	GetAll() (GetAllConnectorsResponse, error)
+	GetAllContext(ctx context.Context) (GetAllConnectorsResponse, error)
+	GetAllExpanded(expandStatus bool, expandInfo bool) (GetAllExpandedConnectorsResponse, error)
+	GetAllExpandedContext(ctx context.Context, expandStatus bool, expandInfo bool) (GetAllExpandedConnectorsResponse, error)
 	GetConnector(req ConnectorRequest) (ConnectorResponse, error)
+	GetConnectorContext(ctx context.Context, req ConnectorRequest) (ConnectorResponse, error)
 	CreateConnector(req CreateConnectorRequest, sync bool) (ConnectorResponse, error)
+	CreateConnectorContext(ctx context.Context, req CreateConnectorRequest, sync bool) (ConnectorResponse, error)
 	UpdateConnector(req CreateConnectorRequest, sync bool) (ConnectorResponse, error)
+	UpdateConnectorContext(ctx context.Context, req CreateConnectorRequest, sync bool) (ConnectorResponse, error)
 	DeleteConnector(req ConnectorRequest, sync bool) (EmptyResponse, error)
+	DeleteConnectorContext(ctx context.Context, req ConnectorRequest, sync bool) (EmptyResponse, error)
 	GetConnectorConfig(req ConnectorRequest) (GetConnectorConfigResponse, error)
+	GetConnectorConfigContext(ctx context.Context, req ConnectorRequest) (GetConnectorConfigResponse, error)
 	GetConnectorStatus(req ConnectorRequest) (GetConnectorStatusResponse, error)
+	GetConnectorStatusContext(ctx context.Context, req ConnectorRequest) (GetConnectorStatusResponse, error)
 	RestartConnector(req ConnectorRequest) (EmptyResponse, error)
+	RestartConnectorContext(ctx context.Context, req ConnectorRequest) (EmptyResponse, error)
+	RestartConnectorWithOptions(req RestartConnectorRequest, sync bool) (RestartConnectorResponse, error)
+	RestartConnectorWithOptionsContext(ctx context.Context, req RestartConnectorRequest, sync bool) (RestartConnectorResponse, error)
 	PauseConnector(req ConnectorRequest, sync bool) (EmptyResponse, error)
+	PauseConnectorContext(ctx context.Context, req ConnectorRequest, sync bool) (EmptyResponse, error)
 	ResumeConnector(req ConnectorRequest, sync bool) (EmptyResponse, error)
+	ResumeConnectorContext(ctx context.Context, req ConnectorRequest, sync bool) (EmptyResponse, error)
+	StopConnector(req ConnectorRequest, sync bool) (EmptyResponse, error)
+	StopConnectorContext(ctx context.Context, req ConnectorRequest, sync bool) (EmptyResponse, error)
 	GetAllTasks(req ConnectorRequest) (GetAllTasksResponse, error)
+	GetAllTasksContext(ctx context.Context, req ConnectorRequest) (GetAllTasksResponse, error)
 	GetTaskStatus(req TaskRequest) (TaskStatusResponse, error)
+	GetTaskStatusContext(ctx context.Context, req TaskRequest) (TaskStatusResponse, error)
 	RestartTask(req TaskRequest) (EmptyResponse, error)
+	RestartTaskContext(ctx context.Context, req TaskRequest) (EmptyResponse, error)
+	GetConnectorPlugins() (GetConnectorPluginsResponse, error)
+	GetConnectorPluginsContext(ctx context.Context) (GetConnectorPluginsResponse, error)
+	ValidateConnectorConfig(req ValidateConnectorConfigRequest) (ValidateConnectorConfigResponse, error)
+	ValidateConnectorConfigContext(ctx context.Context, req ValidateConnectorConfigRequest) (ValidateConnectorConfigResponse, error)
+	GetConnectorOffsets(req ConnectorRequest) (GetConnectorOffsetsResponse, error)
+	GetConnectorOffsetsContext(ctx context.Context, req ConnectorRequest) (GetConnectorOffsetsResponse, error)
+	AlterConnectorOffsets(req AlterConnectorOffsetsRequest, sync bool) (ConnectorOffsetsMessageResponse, error)
+	AlterConnectorOffsetsContext(ctx context.Context, req AlterConnectorOffsetsRequest, sync bool) (ConnectorOffsetsMessageResponse, error)
+	ResetConnectorOffsets(req ConnectorRequest, sync bool) (ConnectorOffsetsMessageResponse, error)
+	ResetConnectorOffsetsContext(ctx context.Context, req ConnectorRequest, sync bool) (ConnectorOffsetsMessageResponse, error)
+	GetConnectorTopics(req ConnectorRequest) (GetConnectorTopicsResponse, error)
+	GetConnectorTopicsContext(ctx context.Context, req ConnectorRequest) (GetConnectorTopicsResponse, error)
+	ResetConnectorTopics(req ConnectorRequest) (EmptyResponse, error)
+	ResetConnectorTopicsContext(ctx context.Context, req ConnectorRequest) (EmptyResponse, error)
+	GetLoggers() (GetLoggersResponse, error)
+	GetLoggersContext(ctx context.Context) (GetLoggersResponse, error)
+	GetLogger(req LoggerRequest) (GetLoggerResponse, error)
+	GetLoggerContext(ctx context.Context, req LoggerRequest) (GetLoggerResponse, error)
+	SetLogLevel(req SetLogLevelRequest) (SetLogLevelResponse, error)
+	SetLogLevelContext(ctx context.Context, req SetLogLevelRequest) (SetLogLevelResponse, error)
 
 	// custom features, mostly composition of previous ones
 	IsUpToDate(connector string, config map[string]interface{}) (bool, error)
+	IsUpToDateContext(ctx context.Context, connector string, config map[string]interface{}) (bool, error)
+	SetDesiredState(req ConnectorRequest, state string, sync bool) (EmptyResponse, error)
+	SetDesiredStateContext(ctx context.Context, req ConnectorRequest, state string, sync bool) (EmptyResponse, error)
 	DeployConnector(req CreateConnectorRequest) (err error)
+	DeployConnectorContext(ctx context.Context, req CreateConnectorRequest) (err error)
 	DeployMultipleConnector(connectors []CreateConnectorRequest) (err error)
+	DeployMultipleConnectorContext(ctx context.Context, connectors []CreateConnectorRequest) (err error)
+	DeployConnectorWithResult(req CreateConnectorRequest) (DeployResult, error)
+	DeployConnectorWithResultContext(ctx context.Context, req CreateConnectorRequest) (DeployResult, error)
+	DeployMultipleConnectorWithResult(connectors []CreateConnectorRequest) ([]DeployResult, error)
+	DeployMultipleConnectorWithResultContext(ctx context.Context, connectors []CreateConnectorRequest) ([]DeployResult, error)
+	Plan(desired []CreateConnectorRequest) (Plan, error)
+	PlanContext(ctx context.Context, desired []CreateConnectorRequest) (Plan, error)
+	Apply(plan Plan) ([]DeployResult, error)
+	ApplyContext(ctx context.Context, plan Plan) ([]DeployResult, error)
+	PlanReconcile(req ReconcileRequest) (Plan, error)
+	PlanReconcileContext(ctx context.Context, req ReconcileRequest) (Plan, error)
+	Reconcile(req ReconcileRequest) ([]DeployResult, error)
+	ReconcileContext(ctx context.Context, req ReconcileRequest) ([]DeployResult, error)
+	ValidateMultipleConnector(connectors []CreateConnectorRequest) (err error)
+	ValidateMultipleConnectorContext(ctx context.Context, connectors []CreateConnectorRequest) (err error)
+	GetAllTopics() (GetAllTopicsResponse, error)
+	GetAllTopicsContext(ctx context.Context) (GetAllTopicsResponse, error)
+	SetLogLevelTemporarily(req SetLogLevelRequest, duration time.Duration) (*TemporaryLogLevel, error)
+	SetLogLevelTemporarilyContext(ctx context.Context, req SetLogLevelRequest, duration time.Duration) (*TemporaryLogLevel, error)
 	SetInsecureSSL()
 	SetDebug()
 	SetClientCertificates(certs ...tls.Certificate)
 	SetParallelism(value int)
+	SetVersionCheck(enabled bool)
+	SetWaitOptions(options WaitOptions)
+	SetHealthCheck(options HealthCheckOptions)
+	SetDeployOptions(options DeployOptions)
 	SetBasicAuth(username string, password string)
+	SetTokenSource(source TokenSource)
 	SetHeader(name string, value string)
+	SetNotFoundAsError(enabled bool)
+	AddInterceptor(interceptors ...Interceptor)
+	SetLogger(logger Logger)
+	SetRateLimit(requestsPerSecond float64, burst int)
+	SetMaxInFlight(max int)
 }
 
 type highLevelClient struct {
 	client             BaseClient
 	maxParallelRequest int
+	waitOptions        WaitOptions
+	healthCheck        HealthCheckOptions
+	deployOptions      DeployOptions
+	operations         []OperationInterceptor
+	logger             Logger
+
+	versionCheck  bool
+	versionLock   sync.Mutex
+	serverVersion string
 }
 
 //NewClient generates a new client
+//Server version is detected on first call to an endpoint that is not supported by every kafka-connect version
 func NewClient(url string) HighLevelClient {
-	return &highLevelClient{client: newBaseClient(url), maxParallelRequest: 3}
+	// default options cannot fail
+	client, _ := NewClientWithOptions(url)
+	return client
+}
+
+//NewClientWithOptions generates a new client configured by the given options
+//Options are applied in order, it fails if one of them is invalid
+func NewClientWithOptions(url string, opts ...Option) (HighLevelClient, error) {
+	options := defaultClientOptions()
+	for _, opt := range opts {
+		if err := opt(&options); err != nil {
+			return nil, err
+		}
+	}
+
+	client, err := newBaseClientWithOptions(url, options)
+	if err != nil {
+		return nil, err
+	}
+	return &highLevelClient{
+		client:             client,
+		maxParallelRequest: options.parallelism,
+		waitOptions:        options.waitOptions,
+		healthCheck:        options.healthCheck,
+		deployOptions:      options.deployOptions,
+		operations:         operationInterceptors(options.interceptors),
+		logger:             options.logger,
+		versionCheck:       true,
+	}, nil
+}
+
+//SetVersionCheck enables or disables checking the server version before calling endpoints it may not support
+//Enabled by default
+func (c *highLevelClient) SetVersionCheck(enabled bool) {
+	c.versionCheck = enabled
+}
+
+// requireVersion returns an UnsupportedFeatureError if the server is known to be older than minVersion
+// If the server version cannot be detected, the request is let through and the server decides
+func (c *highLevelClient) requireVersion(ctx context.Context, feature string, minVersion string) error {
+	if !c.versionCheck {
+		return nil
+	}
+
+	serverVersion := c.detectServerVersion(ctx)
+	if serverVersion == "" {
+		return nil
+	}
+	server, err := parseVersion(serverVersion)
+	if err != nil {
+		return nil
+	}
+	min, err := parseVersion(minVersion)
+	if err != nil {
+		return err
+	}
+
+	if server.before(min) {
+		return &UnsupportedFeatureError{Feature: feature, ServerVersion: serverVersion, MinVersion: minVersion}
+	}
+	return nil
+}
+
+// detectServerVersion lazily fetches the server version, it is retried on next call if it failed
+func (c *highLevelClient) detectServerVersion(ctx context.Context) string {
+	c.versionLock.Lock()
+	defer c.versionLock.Unlock()
+
+	if c.serverVersion == "" {
+		resp, err := c.client.GetWorkerInfoContext(ctx)
+		if err != nil {
+			return ""
+		}
+		c.serverVersion = resp.Version
+	}
+	return c.serverVersion
+}
+
+//SetWaitOptions sets how synchronous operations wait for kafka-connect to apply a change
+//Zero fields keep their default value, see DefaultWaitOptions. Use ContextWithWaitOptions to override them for a single call
+func (c *highLevelClient) SetWaitOptions(options WaitOptions) {
+	c.waitOptions = options
+}
+
+// waitUntil polls exec, with the wait settings of ctx if any, else the client ones
+func (c *highLevelClient) waitUntil(ctx context.Context, operation string, exec check) (err error) {
+	ctx, op := c.startOperation(ctx, "wait "+operation, "")
+	defer func() { op.end(err) }()
+
+	options := c.waitOptions.merge(DefaultWaitOptions())
+	if callOptions, ok := waitOptionsFromContext(ctx); ok {
+		options = callOptions.merge(options)
+	}
+
+	logger := c.log()
+	logger.Debug("waiting for kafka-connect", "operation", operation, "timeout", options.Timeout)
+	checks := 0
+	err = options.poll(ctx, operation, func(ctx context.Context) (interface{}, bool, error) {
+		checks++
+		state, done, err := exec(ctx)
+		if !done {
+			logger.Debug("still waiting for kafka-connect", "operation", operation, "checks", checks, "error", err)
+		}
+		return state, done, err
+	})
+	if err == nil {
+		logger.Debug("done waiting for kafka-connect", "operation", operation, "checks", checks, "duration", time.Since(op.start))
+	}
+	return err
+}
+
+//SetLogger sets the logger receiving events of the client, nothing is logged by default
+func (c *highLevelClient) SetLogger(logger Logger) {
+	c.logger = logger
+	c.client.SetLogger(logger)
+}
+
+//SetRateLimit limits the rate of requests sent by the client with a token bucket, shared by every goroutine using it,
+//including sync waits and retries. burst is the number of requests which can be sent at once after a quiet period.
+//A rate of 0 removes the limit, which is the default
+func (c *highLevelClient) SetRateLimit(requestsPerSecond float64, burst int) {
+	c.client.SetRateLimit(requestsPerSecond, burst)
+}
+
+//SetMaxInFlight limits the number of requests waiting for an answer at once, shared by every goroutine using the client
+//Unlike SetParallelism, it applies to every call, 0 removes the limit, which is the default
+func (c *highLevelClient) SetMaxInFlight(max int) {
+	c.client.SetMaxInFlight(max)
+}
+
+func (c *highLevelClient) log() Logger {
+	if c.logger == nil {
+		return nopLogger{}
+	}
+	return c.logger
+}
+
+// operation is a call to the client being logged, and seen by operation interceptors
+type operation struct {
+	ends   []func(err error)
+	logger Logger
+	fields []interface{}
+	nested bool
+	start  time.Time
+}
+
+type operationKey struct{}
+
+// startOperation starts a client operation, op.end must be called once it is over
+func (c *highLevelClient) startOperation(ctx context.Context, name string, connector string) (context.Context, *operation) {
+	op := &operation{
+		logger: c.log(),
+		fields: []interface{}{"operation", name},
+		nested: ctx.Value(operationKey{}) != nil,
+		start:  time.Now(),
+	}
+	if connector != "" {
+		op.fields = append(op.fields, "connector", connector)
+	}
+	for _, interceptor := range c.operations {
+		var end func(err error)
+		ctx, end = interceptor.StartOperation(ctx, name, connector)
+		op.ends = append(op.ends, end)
+	}
+	return context.WithValue(ctx, operationKey{}, op), op
+}
+
+// end ends the operation for interceptors, in reverse order, failures are logged as errors once, by the outermost operation
+func (o *operation) end(err error) {
+	for i := len(o.ends) - 1; i >= 0; i-- {
+		o.ends[i](err)
+	}
+	if err == nil {
+		return
+	}
+	fields := append(o.fields, "duration", time.Since(o.start), "error", err)
+	if o.nested {
+		o.logger.Debug("operation failed", fields...)
+		return
+	}
+	o.logger.Error("operation failed", fields...)
 }
 
 //Set the limit of parallel call to kafka-connect server
@@ -71,36 +337,99 @@ func (c *highLevelClient) SetBasicAuth(username string, password string) {
 	c.client.SetBasicAuth(username, password)
 }
 
+//SetTokenSource sends a bearer token provided by source with every request
+func (c *highLevelClient) SetTokenSource(source TokenSource) {
+	c.client.SetTokenSource(source)
+}
+
 func (c *highLevelClient) SetHeader(name string, value string) {
 	c.client.SetHeader(name, value)
 }
 
+//AddInterceptor registers interceptors called around every request, after the ones already registered
+func (c *highLevelClient) AddInterceptor(interceptors ...Interceptor) {
+	c.operations = append(c.operations, operationInterceptors(interceptors)...)
+	c.client.AddInterceptor(interceptors...)
+}
+
+//SetNotFoundAsError makes get endpoints return an error matching ErrNotFound when nothing is found,
+//instead of a response with Code 404
+func (c *highLevelClient) SetNotFoundAsError(enabled bool) {
+	c.client.SetNotFoundAsError(enabled)
+}
+
+//GetWorkerInfo return version of the worker and id of the kafka cluster it is connected to
+func (c *highLevelClient) GetWorkerInfo() (WorkerInfoResponse, error) {
+	return c.GetWorkerInfoContext(context.Background())
+}
+
+//GetWorkerInfoContext is GetWorkerInfo with a context, which is used to cancel the request
+func (c *highLevelClient) GetWorkerInfoContext(ctx context.Context) (WorkerInfoResponse, error) {
+	return c.client.GetWorkerInfoContext(ctx)
+}
+
 //GetAll gets the list of all active connectors
 func (c *highLevelClient) GetAll() (GetAllConnectorsResponse, error) {
-	return c.client.GetAll()
+	return c.GetAllContext(context.Background())
+}
+
+//GetAllContext is GetAll with a context, which is used to cancel the request
+func (c *highLevelClient) GetAllContext(ctx context.Context) (GetAllConnectorsResponse, error) {
+	return c.client.GetAllContext(ctx)
+}
+
+//GetAllExpanded gets all active connectors along with their status and/or info in a single call
+func (c *highLevelClient) GetAllExpanded(expandStatus bool, expandInfo bool) (GetAllExpandedConnectorsResponse, error) {
+	return c.GetAllExpandedContext(context.Background(), expandStatus, expandInfo)
+}
+
+//GetAllExpandedContext is GetAllExpanded with a context, which is used to cancel the request
+func (c *highLevelClient) GetAllExpandedContext(ctx context.Context, expandStatus bool, expandInfo bool) (GetAllExpandedConnectorsResponse, error) {
+	if err := c.requireVersion(ctx, "expanded connectors listing", minVersionExpand); err != nil {
+		return GetAllExpandedConnectorsResponse{}, err
+	}
+
+	return c.client.GetAllExpandedContext(ctx, expandStatus, expandInfo)
 }
 
 //GetConnector return information on specific connector
 func (c *highLevelClient) GetConnector(req ConnectorRequest) (ConnectorResponse, error) {
-	return c.client.GetConnector(req)
+	return c.GetConnectorContext(context.Background(), req)
+}
+
+//GetConnectorContext is GetConnector with a context, which is used to cancel the request
+func (c *highLevelClient) GetConnectorContext(ctx context.Context, req ConnectorRequest) (ConnectorResponse, error) {
+	return c.client.GetConnectorContext(ctx, req)
 }
 
 //CreateConnector create connector using specified config and name
 func (c *highLevelClient) CreateConnector(req CreateConnectorRequest, sync bool) (ConnectorResponse, error) {
-	result, err := c.client.CreateConnector(req)
+	return c.CreateConnectorContext(context.Background(), req, sync)
+}
+
+//CreateConnectorContext is CreateConnector with a context, which is used to cancel requests and synchronous waits
+func (c *highLevelClient) CreateConnectorContext(ctx context.Context, req CreateConnectorRequest, sync bool) (result ConnectorResponse, err error) {
+	ctx, op := c.startOperation(ctx, "CreateConnector", req.Name)
+	defer func() { op.end(err) }()
+
+	if req.InitialState != "" {
+		if err := c.requireVersion(ctx, "create connector with initial state", minVersionInitialState); err != nil {
+			return ConnectorResponse{}, err
+		}
+	}
+
+	result, err = c.client.CreateConnectorContext(ctx, req)
 	if err != nil {
 		return result, err
 	}
 
 	if sync {
-		if !tryUntil(
-			func() bool {
-				resp, err := c.GetConnector(req.ConnectorRequest)
-				return err == nil && resp.Code == 200
-			},
-			2*time.Minute,
-		) {
-			return result, errors.New("timeout on creating connector sync")
+		err = c.waitUntil(ctx, "creating connector", func(ctx context.Context) (interface{}, bool, error) {
+			resp, err := c.GetConnectorContext(ctx, req.ConnectorRequest)
+			return resp, err == nil && resp.Code == 200, err
+		})
+		if err != nil {
+			return result, err
 		}
 	}
 
@@ -109,20 +438,26 @@ func (c *highLevelClient) CreateConnector(req CreateConnectorRequest, sync bool)
 
 //UpdateConnector update a connector config
 func (c *highLevelClient) UpdateConnector(req CreateConnectorRequest, sync bool) (ConnectorResponse, error) {
-	result, err := c.client.UpdateConnector(req)
+	return c.UpdateConnectorContext(context.Background(), req, sync)
+}
+
+//UpdateConnectorContext is UpdateConnector with a context, which is used to cancel requests and synchronous waits
+func (c *highLevelClient) UpdateConnectorContext(ctx context.Context, req CreateConnectorRequest, sync bool) (result ConnectorResponse, err error) {
+	ctx, op := c.startOperation(ctx, "UpdateConnector", req.Name)
+	defer func() { op.end(err) }()
+
+	result, err = c.client.UpdateConnectorContext(ctx, req)
 	if err != nil {
 		return result, err
 	}
 
 	if sync {
-		if !tryUntil(
-			func() bool {
-				upToDate, err := c.IsUpToDate(req.Name, req.Config)
-				return err == nil && upToDate
-			},
-			2*time.Minute,
-		) {
-			return result, errors.New("timeout on creating connector sync")
+		err = c.waitUntil(ctx, "updating connector", func(ctx context.Context) (interface{}, bool, error) {
+			resp, err := c.GetConnectorConfigContext(ctx, req.ConnectorRequest)
+			return resp, err == nil && resp.Code < 400 && isConfigUpToDate(req.Name, req.Config, resp.Config), err
+		})
+		if err != nil {
+			return result, err
 		}
 	}
 
@@ -131,20 +466,29 @@ func (c *highLevelClient) UpdateConnector(req CreateConnectorRequest, sync bool)
 
 //DeleteConnector delete a connector
 func (c *highLevelClient) DeleteConnector(req ConnectorRequest, sync bool) (EmptyResponse, error) {
-	result, err := c.client.DeleteConnector(req)
+	return c.DeleteConnectorContext(context.Background(), req, sync)
+}
+
+//DeleteConnectorContext is DeleteConnector with a context, which is used to cancel requests and synchronous waits
+func (c *highLevelClient) DeleteConnectorContext(ctx context.Context, req ConnectorRequest, sync bool) (result EmptyResponse, err error) {
+	ctx, op := c.startOperation(ctx, "DeleteConnector", req.Name)
+	defer func() { op.end(err) }()
+
+	result, err = c.client.DeleteConnectorContext(ctx, req)
 	if err != nil {
 		return result, err
 	}
 
 	if sync {
-		if !tryUntil(
-			func() bool {
-				r, e := c.GetConnector(req)
-				return e == nil && r.Code == 404
-			},
-			2*time.Minute,
-		) {
-			return result, errors.New("timeout on deleting connector sync")
+		err = c.waitUntil(ctx, "deleting connector", func(ctx context.Context) (interface{}, bool, error) {
+			r, e := c.GetConnectorContext(ctx, req)
+			if isNotFound(r.Code, e) {
+				return r, true, nil
+			}
+			return r, false, e
+		})
+		if err != nil {
+			return result, err
 		}
 	}
 
@@ -153,95 +497,295 @@ func (c *highLevelClient) DeleteConnector(req ConnectorRequest, sync bool) (Empt
 
 ////GetConnectorConfig return config of a connector
 func (c *highLevelClient) GetConnectorConfig(req ConnectorRequest) (GetConnectorConfigResponse, error) {
-	return c.client.GetConnectorConfig(req)
+	return c.GetConnectorConfigContext(context.Background(), req)
+}
+
+//GetConnectorConfigContext is GetConnectorConfig with a context, which is used to cancel the request
+func (c *highLevelClient) GetConnectorConfigContext(ctx context.Context, req ConnectorRequest) (GetConnectorConfigResponse, error) {
+	return c.client.GetConnectorConfigContext(ctx, req)
 }
 
 //GetConnectorStatus return current status of connector
 func (c *highLevelClient) GetConnectorStatus(req ConnectorRequest) (GetConnectorStatusResponse, error) {
-	return c.client.GetConnectorStatus(req)
+	return c.GetConnectorStatusContext(context.Background(), req)
+}
+
+//GetConnectorStatusContext is GetConnectorStatus with a context, which is used to cancel the request
+func (c *highLevelClient) GetConnectorStatusContext(ctx context.Context, req ConnectorRequest) (GetConnectorStatusResponse, error) {
+	return c.client.GetConnectorStatusContext(ctx, req)
 }
 
 //RestartConnector restart connector
 func (c *highLevelClient) RestartConnector(req ConnectorRequest) (EmptyResponse, error) {
-	return c.client.RestartConnector(req)
+	return c.RestartConnectorContext(context.Background(), req)
+}
+
+//RestartConnectorContext is RestartConnector with a context, which is used to cancel the request
+func (c *highLevelClient) RestartConnectorContext(ctx context.Context, req ConnectorRequest) (EmptyResponse, error) {
+	return c.client.RestartConnectorContext(ctx, req)
+}
+
+//RestartConnectorWithOptions restart connector and, depending on options, its tasks (kafka-connect 3.0+)
+//In sync mode, it waits for every restarted instance to be up again and reports tasks which ended FAILED
+func (c *highLevelClient) RestartConnectorWithOptions(req RestartConnectorRequest, sync bool) (RestartConnectorResponse, error) {
+	return c.RestartConnectorWithOptionsContext(context.Background(), req, sync)
+}
+
+//RestartConnectorWithOptionsContext is RestartConnectorWithOptions with a context, which is used to cancel requests and synchronous waits
+func (c *highLevelClient) RestartConnectorWithOptionsContext(ctx context.Context, req RestartConnectorRequest, sync bool) (result RestartConnectorResponse, err error) {
+	ctx, op := c.startOperation(ctx, "RestartConnectorWithOptions", req.Name)
+	defer func() { op.end(err) }()
+
+	if err := c.requireVersion(ctx, "restart connector with options", minVersionRestartOptions); err != nil {
+		return RestartConnectorResponse{}, err
+	}
+
+	result, err = c.client.RestartConnectorWithOptionsContext(ctx, req)
+	if err != nil {
+		return result, err
+	}
+
+	if sync {
+		// only instances reported as RESTARTING are restarted, others are left untouched
+		restartedTasks := map[int]bool{}
+		for _, task := range result.TasksStatus {
+			if task.State == StateRestarting {
+				restartedTasks[task.ID] = true
+			}
+		}
+
+		var lastStatus GetConnectorStatusResponse
+		err = c.waitUntil(ctx, "restarting connector", func(ctx context.Context) (interface{}, bool, error) {
+			resp, err := c.GetConnectorStatusContext(ctx, req.ConnectorRequest)
+			if err != nil || resp.Code != 200 || isRestarting(resp.ConnectorStatus["state"]) {
+				return resp, false, err
+			}
+			for _, task := range resp.TasksStatus {
+				if restartedTasks[task.ID] && isRestarting(task.State) {
+					return resp, false, nil
+				}
+			}
+			lastStatus = resp
+			return resp, true, nil
+		})
+		if err != nil {
+			return result, err
+		}
+
+		for _, task := range lastStatus.TasksStatus {
+			if restartedTasks[task.ID] && task.State == StateFailed {
+				result.FailedTasks = append(result.FailedTasks, task)
+			}
+		}
+	}
+
+	return result, nil
+}
+
+// isRestarting checks if a connector or task is still on its way back after a restart
+func isRestarting(state string) bool {
+	return state == StateRestarting || state == StateUnassigned
 }
 
 //PauseConnector pause a running connector
 //asynchronous operation
 func (c *highLevelClient) PauseConnector(req ConnectorRequest, sync bool) (EmptyResponse, error) {
-	result, err := c.client.PauseConnector(req)
+	return c.PauseConnectorContext(context.Background(), req, sync)
+}
+
+//PauseConnectorContext is PauseConnector with a context, which is used to cancel requests and synchronous waits
+func (c *highLevelClient) PauseConnectorContext(ctx context.Context, req ConnectorRequest, sync bool) (result EmptyResponse, err error) {
+	ctx, op := c.startOperation(ctx, "PauseConnector", req.Name)
+	defer func() { op.end(err) }()
+
+	result, err = c.client.PauseConnectorContext(ctx, req)
 	if err != nil {
 		return result, err
 	}
 
 	if sync {
-		if !tryUntil(
-			func() bool {
-				resp, err := c.GetConnectorStatus(req)
-				return err == nil && resp.Code == 200 && resp.ConnectorStatus["state"] == "PAUSED"
-			},
-			2*time.Minute,
-		) {
-			return result, errors.New("timeout on pausing connector sync")
+		err = c.waitUntil(ctx, "pausing connector", func(ctx context.Context) (interface{}, bool, error) {
+			resp, err := c.GetConnectorStatusContext(ctx, req)
+			return resp, err == nil && resp.Code == 200 && resp.ConnectorStatus["state"] == StatePaused, err
+		})
+		if err != nil {
+			return result, err
 		}
 	}
 	return result, nil
 }
 
-//ResumeConnector resume a paused connector
+//ResumeConnector resume a paused or stopped connector
 //asynchronous operation
 func (c *highLevelClient) ResumeConnector(req ConnectorRequest, sync bool) (EmptyResponse, error) {
-	result, err := c.client.ResumeConnector(req)
+	return c.ResumeConnectorContext(context.Background(), req, sync)
+}
+
+//ResumeConnectorContext is ResumeConnector with a context, which is used to cancel requests and synchronous waits
+func (c *highLevelClient) ResumeConnectorContext(ctx context.Context, req ConnectorRequest, sync bool) (result EmptyResponse, err error) {
+	ctx, op := c.startOperation(ctx, "ResumeConnector", req.Name)
+	defer func() { op.end(err) }()
+
+	// a stopped connector has no task anymore, they are only recreated once it runs again
+	fromStopped := false
+	if sync {
+		resp, err := c.GetConnectorStatusContext(ctx, req)
+		if err != nil {
+			return EmptyResponse{}, err
+		}
+		fromStopped = resp.ConnectorStatus["state"] == StateStopped
+	}
+
+	result, err = c.client.ResumeConnectorContext(ctx, req)
 	if err != nil {
 		return result, err
 	}
 
 	if sync {
-		if !tryUntil(
-			func() bool {
-				resp, err := c.GetConnectorStatus(req)
-				return err == nil && resp.Code == 200 && resp.ConnectorStatus["state"] == "RUNNING"
-			},
-			2*time.Minute,
-		) {
-			return result, errors.New("timeout on resuming connector sync")
+		err = c.waitUntil(ctx, "resuming connector", func(ctx context.Context) (interface{}, bool, error) {
+			// tasks of a stopped connector are listed once it generated their configs again, it may have none
+			expectedTasks := 0
+			if fromStopped {
+				info, err := c.GetConnectorContext(ctx, req)
+				if err != nil || info.Code != 200 {
+					return nil, false, err
+				}
+				expectedTasks = len(info.Tasks)
+			}
+			resp, err := c.GetConnectorStatusContext(ctx, req)
+			return resp, err == nil && resp.Code == 200 && isResumed(resp, expectedTasks), err
+		})
+		if err != nil {
+			return result, err
 		}
 	}
 	return result, nil
 }
 
+// isResumed checks that the connector is running, with at least expectedTasks tasks, none of which is still on its way to running
+func isResumed(resp GetConnectorStatusResponse, expectedTasks int) bool {
+	if resp.ConnectorStatus["state"] != StateRunning {
+		return false
+	}
+	if len(resp.TasksStatus) < expectedTasks {
+		return false
+	}
+	for _, task := range resp.TasksStatus {
+		switch task.State {
+		case StatePaused, StateUnassigned, StateRestarting:
+			return false
+		}
+	}
+	return true
+}
+
+//StopConnector stop a connector, shutting down its tasks without deleting its config nor offsets
+//asynchronous operation
+func (c *highLevelClient) StopConnector(req ConnectorRequest, sync bool) (EmptyResponse, error) {
+	return c.StopConnectorContext(context.Background(), req, sync)
+}
+
+//StopConnectorContext is StopConnector with a context, which is used to cancel requests and synchronous waits
+func (c *highLevelClient) StopConnectorContext(ctx context.Context, req ConnectorRequest, sync bool) (result EmptyResponse, err error) {
+	ctx, op := c.startOperation(ctx, "StopConnector", req.Name)
+	defer func() { op.end(err) }()
+
+	if err := c.requireVersion(ctx, "stop connector", minVersionStop); err != nil {
+		return EmptyResponse{}, err
+	}
+
+	result, err = c.client.StopConnectorContext(ctx, req)
+	if err != nil {
+		return result, err
+	}
+
+	if sync {
+		if err := c.waitUntilStopped(ctx, "stopping connector", req); err != nil {
+			return result, err
+		}
+	}
+	return result, nil
+}
+
+//SetDesiredState brings the connector into the given state (RUNNING, PAUSED or STOPPED)
+//It checks the current state first and only issues the needed transition, if any
+func (c *highLevelClient) SetDesiredState(req ConnectorRequest, state string, sync bool) (EmptyResponse, error) {
+	return c.SetDesiredStateContext(context.Background(), req, state, sync)
+}
+
+//SetDesiredStateContext is SetDesiredState with a context, which is used to cancel requests and synchronous waits
+func (c *highLevelClient) SetDesiredStateContext(ctx context.Context, req ConnectorRequest, state string, sync bool) (_ EmptyResponse, err error) {
+	ctx, op := c.startOperation(ctx, "SetDesiredState", req.Name)
+	defer func() { op.end(err) }()
+
+	statusResp, err := c.GetConnectorStatusContext(ctx, req)
+	if isNotFound(statusResp.Code, err) {
+		return EmptyResponse{}, errors.Wrapf(ErrNotFound, "connector %v", req.Name)
+	}
+	if err != nil {
+		return EmptyResponse{}, err
+	}
+	if statusResp.ConnectorStatus["state"] == state {
+		return EmptyResponse{Code: statusResp.Code}, nil
+	}
+
+	switch state {
+	case StateRunning:
+		return c.ResumeConnectorContext(ctx, req, sync)
+	case StatePaused:
+		return c.PauseConnectorContext(ctx, req, sync)
+	case StateStopped:
+		return c.StopConnectorContext(ctx, req, sync)
+	default:
+		return EmptyResponse{}, errors.Errorf("unsupported desired state: %v", state)
+	}
+}
+
 //IsUpToDate checks if the given configuration is different from the deployed one.
 //Returns true if they are the same
 func (c *highLevelClient) IsUpToDate(connector string, config map[string]interface{}) (bool, error) {
-	// copy the map to safely interact with it
-	// we are going to need to add connector name to be able to exact match
-	copyConfig := make(map[string]interface{}, len(config))
-	for key, value := range config {
-		copyConfig[key] = value
-	}
+	return c.IsUpToDateContext(context.Background(), connector, config)
+}
 
-	copyConfig["name"] = connector
+//IsUpToDateContext is IsUpToDate with a context, which is used to cancel requests and synchronous waits
+func (c *highLevelClient) IsUpToDateContext(ctx context.Context, connector string, config map[string]interface{}) (_ bool, err error) {
+	ctx, op := c.startOperation(ctx, "IsUpToDate", connector)
+	defer func() { op.end(err) }()
 
-	configResp, err := c.GetConnectorConfig(ConnectorRequest{Name: connector})
+	configResp, err := c.GetConnectorConfigContext(ctx, ConnectorRequest{Name: connector})
+	if isNotFound(configResp.Code, err) {
+		return false, nil
+	}
 	if err != nil {
 		return false, err
 	}
-	if configResp.Code == 404 {
-		return false, nil
-	}
 	if configResp.Code >= 400 {
 		return false, errors.New(fmt.Sprintf("status code: %d", configResp.Code))
 	}
 
-	if len(configResp.Config) != len(copyConfig) {
-		return false, nil
+	return isConfigUpToDate(connector, config, configResp.Config), nil
+}
+
+// isConfigUpToDate compares a config to deploy with the one deployed
+func isConfigUpToDate(connector string, config map[string]interface{}, deployedConfig map[string]interface{}) bool {
+	// copy the map to safely interact with it
+	// we are going to need to add connector name to be able to exact match
+	copyConfig := make(map[string]interface{}, len(config))
+	for key, value := range config {
+		copyConfig[key] = value
 	}
-	for key, value := range configResp.Config {
+
+	copyConfig["name"] = connector
+
+	if len(deployedConfig) != len(copyConfig) {
+		return false
+	}
+	for key, value := range deployedConfig {
 		if convertConfigValueToString(copyConfig[key]) != convertConfigValueToString(value) {
-			return false, nil
+			return false
 		}
 	}
-	return true, nil
+	return true
 }
 
 // Because trying to compare the same field on 2 different config may return false negative if one is encoded as a string and not the other
@@ -249,72 +793,123 @@ func convertConfigValueToString(value interface{}) string {
 	return fmt.Sprintf("%v", value)
 }
 
-// tryUntil repeats exec until it return true or timeout is reached
-// tryUntil itself return true if `exec` has return true (success), false if timeout (failure)
-func tryUntil(exec func() bool, limit time.Duration) bool {
-	timeLimit := time.After(limit)
+//DeployConnector checks if the configuration changed before deploying.
+//It does nothing if it is the same. An existing connector is updated with the deploy strategy, see SetDeployOptions
+func (c *highLevelClient) DeployConnector(req CreateConnectorRequest) error {
+	return c.DeployConnectorContext(context.Background(), req)
+}
 
-	run := true
-	defer func() { run = false }()
-	success := make(chan bool)
-	go func() {
-		for run {
-			if exec() {
-				success <- true
-				return
-			}
-			time.Sleep(1 * time.Second)
-		}
-	}()
+//DeployConnectorContext is DeployConnector with a context, which is used to cancel requests and synchronous waits
+func (c *highLevelClient) DeployConnectorContext(ctx context.Context, req CreateConnectorRequest) (err error) {
+	ctx, op := c.startOperation(ctx, "DeployConnector", req.Name)
+	defer func() { op.end(err) }()
 
-	select {
-	case <-timeLimit:
-		return false
-	case <-success:
-		return true
-	}
+	_, err = c.deployConnector(ctx, req)
+	return err
 }
 
-//DeployConnector checks if the configuration changed before deploying.
-//It does nothing if it is the same
-func (c *highLevelClient) DeployConnector(req CreateConnectorRequest) error {
-	existingConnector, err := c.GetConnector(ConnectorRequest{Name: req.Name})
-	if err != nil {
-		return err
+// deployConnector creates or updates a connector if its config changed, the result tells what was done
+func (c *highLevelClient) deployConnector(ctx context.Context, req CreateConnectorRequest) (DeployResult, error) {
+	result := DeployResult{Name: req.Name}
+
+	existingConnector, err := c.GetConnectorContext(ctx, ConnectorRequest{Name: req.Name})
+	exists := !isNotFound(existingConnector.Code, err)
+	if exists && err != nil {
+		return result, err
 	}
 
-	if existingConnector.Code != 404 {
-		upToDate, err := c.IsUpToDate(req.Name, req.Config)
+	if exists {
+		upToDate, err := c.IsUpToDateContext(ctx, req.Name, req.Config)
 		if err != nil {
-			return err
+			return result, err
 		}
 		// Connector is already up to date, stop there and return ok
 		if upToDate {
-			return nil
+			c.log().Info("connector up to date, skipping", "connector", req.Name)
+			result.Action = DeployUnchanged
+			return result, nil
 		}
+		result.Action = DeployUpdated
+		result.Diff = diffConfig(req.Config, existingConnector.Config)
+	} else {
+		result.Action = DeployCreated
+		result.Diff = diffConfig(req.Config, nil)
 	}
 
-	_, err = c.UpdateConnector(req, true)
+	// previous is nil only when the connector is created
+	var previous map[string]interface{}
+	if exists {
+		previous = existingConnector.Config
+		if previous == nil {
+			previous = map[string]interface{}{}
+		}
+	}
+	c.log().Info("deploying connector", "connector", req.Name, "action", result.Action, "diff", result.Diff.String())
+	err = c.updateConnector(ctx, req, previous, &result)
 
-	return err
+	return result, err
+}
+
+//DeployMultipleConnector deploys connectors in parallel, see DeployConnector
+//Deployed configs are fetched in a single call first, so that connectors already up to date cost no more request
+func (c *highLevelClient) DeployMultipleConnector(connectors []CreateConnectorRequest) error {
+	return c.DeployMultipleConnectorContext(context.Background(), connectors)
+}
+
+//DeployMultipleConnectorContext is DeployMultipleConnector with a context, which is used to cancel requests and synchronous waits
+func (c *highLevelClient) DeployMultipleConnectorContext(ctx context.Context, connectors []CreateConnectorRequest) (err error) {
+	ctx, op := c.startOperation(ctx, "DeployMultipleConnector", "")
+	defer func() { op.end(err) }()
+
+	return c.deployEach(ctx, connectors, false,
+		func(_ int, req CreateConnectorRequest) error {
+			return c.DeployConnectorContext(ctx, req)
+		},
+		func(_ int, _ ExpandedConnector) {})
+}
+
+// deployEach calls deploy for every connector in parallel, maxParallelRequest at most at once
+// Deployed connectors are fetched in a single call first, those already up to date are passed to unchanged instead
+func (c *highLevelClient) deployEach(ctx context.Context, connectors []CreateConnectorRequest, expandStatus bool,
+	deploy func(i int, req CreateConnectorRequest) error, unchanged func(i int, existing ExpandedConnector)) error {
+	// if it fails (expand is not supported by older kafka-connect), every connector is checked by DeployConnector
+	deployed, expandErr := c.GetAllExpandedContext(ctx, expandStatus, true)
+
+	return c.runEach(ctx, connectors, func(i int, req CreateConnectorRequest) error {
+		if expandErr == nil {
+			if existing, ok := deployed.Connectors[req.Name]; ok && isConfigUpToDate(req.Name, req.Config, existing.Info.Config) {
+				c.log().Info("connector up to date, skipping", "connector", req.Name)
+				unchanged(i, existing)
+				return nil
+			}
+		}
+		return deploy(i, req)
+	})
 }
 
-func (c *highLevelClient) DeployMultipleConnector(connectors []CreateConnectorRequest) (err error) {
+// runEach calls deploy for every connector in parallel, maxParallelRequest at most at once
+// Nothing is started once ctx is cancelled, errors are gathered in a multierror
+func (c *highLevelClient) runEach(ctx context.Context, connectors []CreateConnectorRequest, deploy func(i int, req CreateConnectorRequest) error) (err error) {
 	errSync := new(sync.Mutex)
 	// Channel is used only to limit number of parallel request
 	throttleCh := make(chan interface{}, c.maxParallelRequest)
 
-	for _, connector := range connectors {
+	for i, connector := range connectors {
 		throttleCh <- struct{}{}
-		go func(req CreateConnectorRequest) {
+		// do not start new deployments once cancelled, running ones are stopped through ctx
+		if ctx.Err() != nil {
+			<-throttleCh
+			break
+		}
+		go func(i int, req CreateConnectorRequest) {
 			defer func() { <-throttleCh }()
-			newErr := c.DeployConnector(req)
+			newErr := deploy(i, req)
 			if newErr != nil {
 				errSync.Lock()
 				defer errSync.Unlock()
 				err = multierror.Append(err, errors.Wrapf(newErr, "error while deploying: %v", req.Name))
 			}
-		}(connector)
+		}(i, connector)
 	}
 
 	// wait for the end
@@ -322,6 +917,9 @@ func (c *highLevelClient) DeployMultipleConnector(connectors []CreateConnectorRe
 		throttleCh <- struct{}{}
 	}
 
+	if ctx.Err() != nil {
+		err = multierror.Append(err, ctx.Err())
+	}
 	return err
 }
 
@@ -329,15 +927,377 @@ func (c *highLevelClient) DeployMultipleConnector(connectors []CreateConnectorRe
 
 //GetAllTasks return list of running task
 func (c *highLevelClient) GetAllTasks(req ConnectorRequest) (GetAllTasksResponse, error) {
-	return c.client.GetAllTasks(req)
+	return c.GetAllTasksContext(context.Background(), req)
+}
+
+//GetAllTasksContext is GetAllTasks with a context, which is used to cancel the request
+func (c *highLevelClient) GetAllTasksContext(ctx context.Context, req ConnectorRequest) (GetAllTasksResponse, error) {
+	return c.client.GetAllTasksContext(ctx, req)
 }
 
 //GetTaskStatus return current status of task
 func (c *highLevelClient) GetTaskStatus(req TaskRequest) (TaskStatusResponse, error) {
-	return c.client.GetTaskStatus(req)
+	return c.GetTaskStatusContext(context.Background(), req)
+}
+
+//GetTaskStatusContext is GetTaskStatus with a context, which is used to cancel the request
+func (c *highLevelClient) GetTaskStatusContext(ctx context.Context, req TaskRequest) (TaskStatusResponse, error) {
+	return c.client.GetTaskStatusContext(ctx, req)
 }
 
 //RestartTask try to restart task
 func (c *highLevelClient) RestartTask(req TaskRequest) (EmptyResponse, error) {
-	return c.client.RestartTask(req)
+	return c.RestartTaskContext(context.Background(), req)
+}
+
+//RestartTaskContext is RestartTask with a context, which is used to cancel the request
+func (c *highLevelClient) RestartTaskContext(ctx context.Context, req TaskRequest) (EmptyResponse, error) {
+	return c.client.RestartTaskContext(ctx, req)
+}
+
+// --------------- connector plugins ---------------------
+
+//GetConnectorPlugins return the list of connector plugins installed on the cluster
+func (c *highLevelClient) GetConnectorPlugins() (GetConnectorPluginsResponse, error) {
+	return c.GetConnectorPluginsContext(context.Background())
+}
+
+//GetConnectorPluginsContext is GetConnectorPlugins with a context, which is used to cancel the request
+func (c *highLevelClient) GetConnectorPluginsContext(ctx context.Context) (GetConnectorPluginsResponse, error) {
+	return c.client.GetConnectorPluginsContext(ctx)
+}
+
+//ValidateConnectorConfig validate a config against the given connector plugin without creating anything
+func (c *highLevelClient) ValidateConnectorConfig(req ValidateConnectorConfigRequest) (ValidateConnectorConfigResponse, error) {
+	return c.ValidateConnectorConfigContext(context.Background(), req)
+}
+
+//ValidateConnectorConfigContext is ValidateConnectorConfig with a context, which is used to cancel the request
+func (c *highLevelClient) ValidateConnectorConfigContext(ctx context.Context, req ValidateConnectorConfigRequest) (ValidateConnectorConfigResponse, error) {
+	return c.client.ValidateConnectorConfigContext(ctx, req)
+}
+
+//ValidateMultipleConnector validates every connector config against its plugin
+//It is meant to be called before DeployMultipleConnector so that an invalid config does not stop a deployment halfway
+func (c *highLevelClient) ValidateMultipleConnector(connectors []CreateConnectorRequest) error {
+	return c.ValidateMultipleConnectorContext(context.Background(), connectors)
+}
+
+//ValidateMultipleConnectorContext is ValidateMultipleConnector with a context, which is used to cancel requests and synchronous waits
+func (c *highLevelClient) ValidateMultipleConnectorContext(ctx context.Context, connectors []CreateConnectorRequest) (err error) {
+	ctx, op := c.startOperation(ctx, "ValidateMultipleConnector", "")
+	defer func() { op.end(err) }()
+
+	for _, connector := range connectors {
+		class, ok := connector.Config["connector.class"]
+		if !ok {
+			err = multierror.Append(err, errors.Errorf("invalid config for %v: missing connector.class", connector.Name))
+			continue
+		}
+
+		// name is a required field of every connector config, but is usually only given in the request
+		config := make(map[string]interface{}, len(connector.Config)+1)
+		for key, value := range connector.Config {
+			config[key] = value
+		}
+		config["name"] = connector.Name
+
+		resp, newErr := c.ValidateConnectorConfigContext(ctx, ValidateConnectorConfigRequest{
+			Class:  convertConfigValueToString(class),
+			Config: config,
+		})
+		if newErr != nil {
+			err = multierror.Append(err, errors.Wrapf(newErr, "error while validating: %v", connector.Name))
+			continue
+		}
+		if resp.ErrorCount > 0 {
+			err = multierror.Append(err, errors.Errorf("invalid config for %v: %v", connector.Name, resp.FieldErrors()))
+		}
+	}
+
+	return err
+}
+
+// --------------- offsets ---------------------
+
+//GetConnectorOffsets return current offsets of a connector
+func (c *highLevelClient) GetConnectorOffsets(req ConnectorRequest) (GetConnectorOffsetsResponse, error) {
+	return c.GetConnectorOffsetsContext(context.Background(), req)
+}
+
+//GetConnectorOffsetsContext is GetConnectorOffsets with a context, which is used to cancel the request
+func (c *highLevelClient) GetConnectorOffsetsContext(ctx context.Context, req ConnectorRequest) (GetConnectorOffsetsResponse, error) {
+	if err := c.requireVersion(ctx, "get connector offsets", minVersionGetOffsets); err != nil {
+		return GetConnectorOffsetsResponse{}, err
+	}
+
+	return c.client.GetConnectorOffsetsContext(ctx, req)
+}
+
+//AlterConnectorOffsets alter offsets of a connector
+//connector must be STOPPED, if sync is set it waits for the connector to be STOPPED before altering offsets
+func (c *highLevelClient) AlterConnectorOffsets(req AlterConnectorOffsetsRequest, sync bool) (ConnectorOffsetsMessageResponse, error) {
+	return c.AlterConnectorOffsetsContext(context.Background(), req, sync)
+}
+
+//AlterConnectorOffsetsContext is AlterConnectorOffsets with a context, which is used to cancel requests and synchronous waits
+func (c *highLevelClient) AlterConnectorOffsetsContext(ctx context.Context, req AlterConnectorOffsetsRequest, sync bool) (_ ConnectorOffsetsMessageResponse, err error) {
+	ctx, op := c.startOperation(ctx, "AlterConnectorOffsets", req.Name)
+	defer func() { op.end(err) }()
+
+	if err := c.requireVersion(ctx, "alter connector offsets", minVersionAlterOffsets); err != nil {
+		return ConnectorOffsetsMessageResponse{}, err
+	}
+
+	if sync {
+		if err := c.waitUntilStopped(ctx, "waiting connector to be stopped before altering offsets", req.ConnectorRequest); err != nil {
+			return ConnectorOffsetsMessageResponse{}, err
+		}
+	}
+
+	return c.client.AlterConnectorOffsetsContext(ctx, req)
+}
+
+//ResetConnectorOffsets reset all offsets of a connector
+//connector must be STOPPED, if sync is set it waits for the connector to be STOPPED before resetting offsets
+func (c *highLevelClient) ResetConnectorOffsets(req ConnectorRequest, sync bool) (ConnectorOffsetsMessageResponse, error) {
+	return c.ResetConnectorOffsetsContext(context.Background(), req, sync)
+}
+
+//ResetConnectorOffsetsContext is ResetConnectorOffsets with a context, which is used to cancel requests and synchronous waits
+func (c *highLevelClient) ResetConnectorOffsetsContext(ctx context.Context, req ConnectorRequest, sync bool) (_ ConnectorOffsetsMessageResponse, err error) {
+	ctx, op := c.startOperation(ctx, "ResetConnectorOffsets", req.Name)
+	defer func() { op.end(err) }()
+
+	if err := c.requireVersion(ctx, "reset connector offsets", minVersionAlterOffsets); err != nil {
+		return ConnectorOffsetsMessageResponse{}, err
+	}
+
+	if sync {
+		if err := c.waitUntilStopped(ctx, "waiting connector to be stopped before resetting offsets", req); err != nil {
+			return ConnectorOffsetsMessageResponse{}, err
+		}
+	}
+
+	return c.client.ResetConnectorOffsetsContext(ctx, req)
+}
+
+func (c *highLevelClient) waitUntilStopped(ctx context.Context, operation string, req ConnectorRequest) error {
+	return c.waitUntil(ctx, operation, func(ctx context.Context) (interface{}, bool, error) {
+		resp, err := c.GetConnectorStatusContext(ctx, req)
+		return resp, err == nil && resp.Code == 200 && resp.ConnectorStatus["state"] == StateStopped, err
+	})
+}
+
+// --------------- topics ---------------------
+
+//GetAllTopicsResponse is response returned by GetAllTopics
+type GetAllTopicsResponse struct {
+	// Topics lists connectors which used each topic, indexed by topic
+	Topics map[string][]string
+}
+
+//GetConnectorTopics return the set of topics used by a connector
+func (c *highLevelClient) GetConnectorTopics(req ConnectorRequest) (GetConnectorTopicsResponse, error) {
+	return c.GetConnectorTopicsContext(context.Background(), req)
+}
+
+//GetConnectorTopicsContext is GetConnectorTopics with a context, which is used to cancel the request
+func (c *highLevelClient) GetConnectorTopicsContext(ctx context.Context, req ConnectorRequest) (GetConnectorTopicsResponse, error) {
+	if err := c.requireVersion(ctx, "get connector topics", minVersionTopics); err != nil {
+		return GetConnectorTopicsResponse{}, err
+	}
+
+	return c.client.GetConnectorTopicsContext(ctx, req)
+}
+
+//ResetConnectorTopics empty the set of topics used by a connector
+func (c *highLevelClient) ResetConnectorTopics(req ConnectorRequest) (EmptyResponse, error) {
+	return c.ResetConnectorTopicsContext(context.Background(), req)
+}
+
+//ResetConnectorTopicsContext is ResetConnectorTopics with a context, which is used to cancel the request
+func (c *highLevelClient) ResetConnectorTopicsContext(ctx context.Context, req ConnectorRequest) (EmptyResponse, error) {
+	if err := c.requireVersion(ctx, "reset connector topics", minVersionTopics); err != nil {
+		return EmptyResponse{}, err
+	}
+
+	return c.client.ResetConnectorTopicsContext(ctx, req)
+}
+
+//GetAllTopics gathers topics used by every connector, and indexes connectors by topic
+func (c *highLevelClient) GetAllTopics() (GetAllTopicsResponse, error) {
+	return c.GetAllTopicsContext(context.Background())
+}
+
+//GetAllTopicsContext is GetAllTopics with a context, which is used to cancel requests and synchronous waits
+func (c *highLevelClient) GetAllTopicsContext(ctx context.Context) (result GetAllTopicsResponse, err error) {
+	ctx, op := c.startOperation(ctx, "GetAllTopics", "")
+	defer func() { op.end(err) }()
+
+	if err := c.requireVersion(ctx, "get connector topics", minVersionTopics); err != nil {
+		return GetAllTopicsResponse{}, err
+	}
+
+	all, err := c.GetAllContext(ctx)
+	if err != nil {
+		return GetAllTopicsResponse{}, err
+	}
+
+	result.Topics = map[string][]string{}
+	resultSync := new(sync.Mutex)
+	// Channel is used only to limit number of parallel request
+	throttleCh := make(chan interface{}, c.maxParallelRequest)
+
+	for _, connector := range all.Connectors {
+		throttleCh <- struct{}{}
+		if ctx.Err() != nil {
+			<-throttleCh
+			break
+		}
+		go func(name string) {
+			defer func() { <-throttleCh }()
+			resp, newErr := c.GetConnectorTopicsContext(ctx, ConnectorRequest{Name: name})
+
+			resultSync.Lock()
+			defer resultSync.Unlock()
+			if newErr != nil {
+				err = multierror.Append(err, errors.Wrapf(newErr, "error while getting topics of: %v", name))
+				return
+			}
+			for _, topic := range resp.Topics {
+				result.Topics[topic] = append(result.Topics[topic], name)
+			}
+		}(connector)
+	}
+
+	// wait for the end
+	for i := 0; i < c.maxParallelRequest; i++ {
+		throttleCh <- struct{}{}
+	}
+
+	if ctx.Err() != nil {
+		return GetAllTopicsResponse{}, ctx.Err()
+	}
+	for _, connectors := range result.Topics {
+		sort.Strings(connectors)
+	}
+
+	return result, err
+}
+
+// --------------- loggers ---------------------
+
+//TemporaryLogLevel is a log level change which is reverted once its duration elapsed
+type TemporaryLogLevel struct {
+	SetLogLevelResponse
+	PreviousLevel string
+
+	timer   *time.Timer
+	restore func() error
+	once    sync.Once
+	done    chan error
+}
+
+//Restore reverts the log level right away, rather than waiting for the duration to elapse
+//Calling it after the level was already restored does nothing
+func (t *TemporaryLogLevel) Restore() error {
+	t.timer.Stop()
+	return t.doRestore()
+}
+
+func (t *TemporaryLogLevel) doRestore() (err error) {
+	t.once.Do(func() {
+		err = t.restore()
+		t.done <- err
+		close(t.done)
+	})
+	return err
+}
+
+//Done returns a channel receiving the result of restoring the previous level, once it happened
+func (t *TemporaryLogLevel) Done() <-chan error {
+	return t.done
+}
+
+//GetLoggers return level of every logger explicitly configured on the worker
+func (c *highLevelClient) GetLoggers() (GetLoggersResponse, error) {
+	return c.GetLoggersContext(context.Background())
+}
+
+//GetLoggersContext is GetLoggers with a context, which is used to cancel the request
+func (c *highLevelClient) GetLoggersContext(ctx context.Context) (GetLoggersResponse, error) {
+	if err := c.requireVersion(ctx, "loggers", minVersionLoggers); err != nil {
+		return GetLoggersResponse{}, err
+	}
+
+	return c.client.GetLoggersContext(ctx)
+}
+
+//GetLogger return level of a logger on the worker
+func (c *highLevelClient) GetLogger(req LoggerRequest) (GetLoggerResponse, error) {
+	return c.GetLoggerContext(context.Background(), req)
+}
+
+//GetLoggerContext is GetLogger with a context, which is used to cancel the request
+func (c *highLevelClient) GetLoggerContext(ctx context.Context, req LoggerRequest) (GetLoggerResponse, error) {
+	if err := c.requireVersion(ctx, "loggers", minVersionLoggers); err != nil {
+		return GetLoggerResponse{}, err
+	}
+
+	return c.client.GetLoggerContext(ctx, req)
+}
+
+//SetLogLevel change level of a logger and its children
+func (c *highLevelClient) SetLogLevel(req SetLogLevelRequest) (SetLogLevelResponse, error) {
+	return c.SetLogLevelContext(context.Background(), req)
+}
+
+//SetLogLevelContext is SetLogLevel with a context, which is used to cancel requests and synchronous waits
+func (c *highLevelClient) SetLogLevelContext(ctx context.Context, req SetLogLevelRequest) (SetLogLevelResponse, error) {
+	minVersion := minVersionLoggers
+	if req.Scope == LogScopeCluster {
+		minVersion = minVersionClusterScopedLogs
+	}
+	if err := c.requireVersion(ctx, "set log level with "+req.Scope+" scope", minVersion); err != nil {
+		return SetLogLevelResponse{}, err
+	}
+
+	return c.client.SetLogLevelContext(ctx, req)
+}
+
+//SetLogLevelTemporarily change level of a logger, and restores its previous level once duration elapsed
+//It prevents debug logging from being left on by mistake. The restore happens in background,
+//so the caller must stay alive until Done is notified, or call Restore itself before exiting.
+func (c *highLevelClient) SetLogLevelTemporarily(req SetLogLevelRequest, duration time.Duration) (*TemporaryLogLevel, error) {
+	return c.SetLogLevelTemporarilyContext(context.Background(), req, duration)
+}
+
+//SetLogLevelTemporarilyContext is SetLogLevelTemporarily with a context, which is used to cancel requests and synchronous waits
+func (c *highLevelClient) SetLogLevelTemporarilyContext(ctx context.Context, req SetLogLevelRequest, duration time.Duration) (*TemporaryLogLevel, error) {
+	previous, err := c.GetLoggerContext(ctx, req.LoggerRequest)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get previous log level")
+	}
+
+	result, err := c.SetLogLevelContext(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	restoreReq := req
+	restoreReq.Level = previous.Level
+	temporary := &TemporaryLogLevel{
+		SetLogLevelResponse: result,
+		PreviousLevel:       previous.Level,
+		// restoring happens later on, it must not be bound to the context of this call
+		restore: func() error {
+			_, err := c.SetLogLevelContext(context.Background(), restoreReq)
+			return err
+		},
+		done: make(chan error, 1),
+	}
+	temporary.timer = time.AfterFunc(duration, func() { _ = temporary.doRestore() })
+
+	return temporary, nil
 }
@@ -3,11 +3,13 @@
 package connectors
 
 import (
+	"context"
 	"reflect"
 	"sync"
 	"testing"
 	"time"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/pkg/errors"
 
 	"bou.ke/monkey"
@@ -30,7 +32,7 @@ func Test_IsUpToDate_Should_Be_True(t *testing.T) {
 	}
 
 	mockBaseClient := &MockBaseClient{}
-	mockBaseClient.On("GetConnectorConfig", mock.Anything).
+	mockBaseClient.On("GetConnectorConfigContext", mock.Anything, mock.Anything).
 		Return(GetConnectorConfigResponse{Config: configOnline}, nil)
 
 	client := &highLevelClient{client: mockBaseClient}
@@ -56,7 +58,7 @@ func Test_IsUpToDate_Should_Be_False(t *testing.T) {
 	}
 
 	mockBaseClient := &MockBaseClient{}
-	mockBaseClient.On("GetConnectorConfig", mock.Anything).
+	mockBaseClient.On("GetConnectorConfigContext", mock.Anything, mock.Anything).
 		Return(GetConnectorConfigResponse{Config: configOnline}, nil)
 
 	client := &highLevelClient{client: mockBaseClient}
@@ -67,25 +69,67 @@ func Test_IsUpToDate_Should_Be_False(t *testing.T) {
 	assert.False(t, isUpToDate)
 }
 
-func Test_tryUntil_When_Success(t *testing.T) {
-	result := tryUntil(
-		func() bool {
-			return true
-		},
-		100*time.Millisecond,
-	)
-	assert.True(t, result)
+func Test_PauseConnector_Sync_When_Cancelled(t *testing.T) {
+	mockBaseClient := &MockBaseClient{}
+	mockBaseClient.On("PauseConnectorContext", mock.Anything, ConnectorRequest{Name: "test1"}).
+		Return(EmptyResponse{Code: 202}, nil)
+	mockBaseClient.On("GetConnectorStatusContext", mock.Anything, ConnectorRequest{Name: "test1"}).
+		Return(GetConnectorStatusResponse{EmptyResponse: EmptyResponse{Code: 200}, ConnectorStatus: map[string]string{"state": StateRunning}}, nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(50*time.Millisecond, cancel)
+
+	client := &highLevelClient{client: mockBaseClient}
+	_, err := client.PauseConnectorContext(ctx, ConnectorRequest{Name: "test1"}, true)
+
+	assert.Error(t, err)
+	assert.True(t, errors.Is(err, context.Canceled))
 }
 
-func Test_tryUntil_When_Timeout(t *testing.T) {
-	result := tryUntil(
-		func() bool {
-			time.Sleep(200 * time.Millisecond)
-			return true
-		},
-		100*time.Millisecond,
-	)
-	assert.False(t, result)
+func Test_PauseConnector_Sync_When_Timeout(t *testing.T) {
+	running := GetConnectorStatusResponse{EmptyResponse: EmptyResponse{Code: 200}, ConnectorStatus: map[string]string{"state": StateRunning}}
+	mockBaseClient := &MockBaseClient{}
+	mockBaseClient.On("PauseConnectorContext", mock.Anything, ConnectorRequest{Name: "test1"}).
+		Return(EmptyResponse{Code: 202}, nil)
+	mockBaseClient.On("GetConnectorStatusContext", mock.Anything, ConnectorRequest{Name: "test1"}).
+		Return(running, nil)
+
+	client := &highLevelClient{client: mockBaseClient}
+	client.SetWaitOptions(WaitOptions{Timeout: time.Minute, Interval: 10 * time.Millisecond})
+	// per call options take precedence over client ones
+	ctx := ContextWithWaitOptions(context.Background(), WaitOptions{Timeout: 100 * time.Millisecond})
+	_, err := client.PauseConnectorContext(ctx, ConnectorRequest{Name: "test1"}, true)
+
+	assert.True(t, errors.Is(err, ErrWaitTimeout))
+	var timeoutErr *WaitTimeoutError
+	assert.True(t, errors.As(err, &timeoutErr))
+	assert.Equal(t, "pausing connector", timeoutErr.Operation)
+	assert.Equal(t, running, timeoutErr.LastState)
+}
+
+func Test_UpdateConnector_Sync_When_Timeout_Redacts_Config(t *testing.T) {
+	mockBaseClient := &MockBaseClient{}
+	mockBaseClient.On("UpdateConnectorContext", mock.Anything, mock.Anything).
+		Return(ConnectorResponse{EmptyResponse: EmptyResponse{Code: 200}, Name: "test1"}, nil)
+	mockBaseClient.On("GetConnectorConfigContext", mock.Anything, ConnectorRequest{Name: "test1"}).
+		Return(GetConnectorConfigResponse{EmptyResponse: EmptyResponse{Code: 200}, Config: map[string]interface{}{
+			"name":                "test1",
+			"connection.password": "old",
+		}}, nil)
+
+	client := &highLevelClient{client: mockBaseClient}
+	ctx := ContextWithWaitOptions(context.Background(), WaitOptions{Timeout: 50 * time.Millisecond, Interval: 10 * time.Millisecond})
+	_, err := client.UpdateConnectorContext(ctx, CreateConnectorRequest{
+		ConnectorRequest: ConnectorRequest{Name: "test1"},
+		Config:           map[string]interface{}{"connection.password": "new"},
+	}, true)
+
+	var timeoutErr *WaitTimeoutError
+	assert.True(t, errors.As(err, &timeoutErr))
+	assert.Equal(t, GetConnectorConfigResponse{EmptyResponse: EmptyResponse{Code: 200}, Config: map[string]interface{}{
+		"name":                "test1",
+		"connection.password": "[REDACTED]",
+	}}, timeoutErr.LastState)
 }
 
 func Test_DeployConnector_When_Already_Up_To_Date(t *testing.T) {
@@ -102,10 +146,10 @@ func Test_DeployConnector_When_Already_Up_To_Date(t *testing.T) {
 	}
 
 	mockBaseClient := &MockBaseClient{}
-	mockBaseClient.On("GetConnector", mock.Anything).
+	mockBaseClient.On("GetConnectorContext", mock.Anything, mock.Anything).
 		Return(ConnectorResponse{Name: "test1", Config: configOnline}, nil)
 	//TODO there shouldn't be a need to make both these call
-	mockBaseClient.On("GetConnectorConfig", mock.Anything).
+	mockBaseClient.On("GetConnectorConfigContext", mock.Anything, mock.Anything).
 		Return(GetConnectorConfigResponse{Config: configOnline}, nil)
 	// note we don't mock the update part because it should not be called
 
@@ -119,6 +163,26 @@ func Test_DeployConnector_When_Already_Up_To_Date(t *testing.T) {
 	mockBaseClient.AssertExpectations(t)
 }
 
+func Test_DeployConnector_When_Not_Found_As_Error(t *testing.T) {
+	notFound := &APIError{Operation: "Get connector", StatusCode: 404}
+	mockBaseClient := &MockBaseClient{}
+	mockBaseClient.On("GetConnectorContext", mock.Anything, mock.Anything).
+		Return(ConnectorResponse{}, notFound)
+	mockBaseClient.On("UpdateConnectorContext", mock.Anything, mock.Anything).
+		Return(ConnectorResponse{}, nil)
+	mockBaseClient.On("GetConnectorConfigContext", mock.Anything, mock.Anything).
+		Return(GetConnectorConfigResponse{Config: map[string]interface{}{"name": "test1", "param1": 3}}, nil)
+
+	client := &highLevelClient{client: mockBaseClient}
+	err := client.DeployConnector(CreateConnectorRequest{
+		ConnectorRequest: ConnectorRequest{"test1"},
+		Config:           map[string]interface{}{"param1": 3},
+	})
+
+	assert.NoError(t, err)
+	mockBaseClient.AssertExpectations(t)
+}
+
 func Test_DeployConnector_Ok(t *testing.T) {
 	configOnline := map[string]interface{}{
 		"name":   "test1",
@@ -139,13 +203,13 @@ func Test_DeployConnector_Ok(t *testing.T) {
 	// - loop get connector status until it is running
 
 	mockBaseClient := &MockBaseClient{}
-	mockBaseClient.On("GetConnector", mock.Anything).
+	mockBaseClient.On("GetConnectorContext", mock.Anything, mock.Anything).
 		Return(ConnectorResponse{Name: "test1", Config: configOnline}, nil)
-	mockBaseClient.On("GetConnectorConfig", mock.Anything).
+	mockBaseClient.On("GetConnectorConfigContext", mock.Anything, mock.Anything).
 		Return(GetConnectorConfigResponse{Config: configOnline}, nil).Once()
-	mockBaseClient.On("UpdateConnector", mock.Anything).
+	mockBaseClient.On("UpdateConnectorContext", mock.Anything, mock.Anything).
 		Return(ConnectorResponse{}, nil)
-	mockBaseClient.On("GetConnectorConfig", mock.Anything).
+	mockBaseClient.On("GetConnectorConfigContext", mock.Anything, mock.Anything).
 		Return(GetConnectorConfigResponse{Config: map[string]interface{}{"name": "test1", "param1": 3}}, nil).Once()
 
 	client := &highLevelClient{client: mockBaseClient}
@@ -159,19 +223,23 @@ func Test_DeployConnector_Ok(t *testing.T) {
 }
 
 func Test_DeployMultipleConnector_Ok(t *testing.T) {
-	client := &highLevelClient{client: &MockBaseClient{}, maxParallelRequest: 2}
+	mockBaseClient := &MockBaseClient{}
+	mockBaseClient.On("GetAllExpandedContext", mock.Anything, false, true).
+		Return(GetAllExpandedConnectorsResponse{}, nil)
+
+	client := &highLevelClient{client: mockBaseClient, maxParallelRequest: 2}
 
 	lock := &sync.Mutex{}
 	received := map[string]interface{}{}
 
 	// Don't want to mock every baseClient call, so I am going the lazy way.
-	patch := monkey.PatchInstanceMethod(reflect.TypeOf(client), "DeployConnector", func(_ *highLevelClient, req CreateConnectorRequest) (err error) {
+	patch := monkey.PatchInstanceMethod(reflect.TypeOf(client), "DeployConnectorContext", func(_ *highLevelClient, _ context.Context, req CreateConnectorRequest) (err error) {
 		lock.Lock()
 		defer lock.Unlock()
 		received[req.Name] = true
 		return nil
 	})
-	defer patch.Restore()
+	defer patch.Unpatch()
 
 	err := client.DeployMultipleConnector([]CreateConnectorRequest{
 		{ConnectorRequest: ConnectorRequest{Name: "test1"}},
@@ -185,14 +253,49 @@ func Test_DeployMultipleConnector_Ok(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func Test_DeployMultipleConnector_Skip_Up_To_Date(t *testing.T) {
+	mockBaseClient := &MockBaseClient{}
+	mockBaseClient.On("GetAllExpandedContext", mock.Anything, false, true).
+		Return(GetAllExpandedConnectorsResponse{Connectors: map[string]ExpandedConnector{
+			"test1": {Info: ConnectorResponse{Name: "test1", Config: map[string]interface{}{"name": "test1", "param1": "2"}}},
+			"test2": {Info: ConnectorResponse{Name: "test2", Config: map[string]interface{}{"name": "test2", "param1": "2"}}},
+		}}, nil)
+
+	client := &highLevelClient{client: mockBaseClient, maxParallelRequest: 2}
+
+	lock := &sync.Mutex{}
+	received := map[string]interface{}{}
+
+	patch := monkey.PatchInstanceMethod(reflect.TypeOf(client), "DeployConnectorContext", func(_ *highLevelClient, _ context.Context, req CreateConnectorRequest) (err error) {
+		lock.Lock()
+		defer lock.Unlock()
+		received[req.Name] = true
+		return nil
+	})
+	defer patch.Unpatch()
+
+	err := client.DeployMultipleConnector([]CreateConnectorRequest{
+		{ConnectorRequest: ConnectorRequest{Name: "test1"}, Config: map[string]interface{}{"param1": 2}},
+		{ConnectorRequest: ConnectorRequest{Name: "test2"}, Config: map[string]interface{}{"param1": 3}},
+		{ConnectorRequest: ConnectorRequest{Name: "test3"}, Config: map[string]interface{}{"param1": 2}},
+	})
+
+	assert.Equal(t, map[string]interface{}{"test2": true, "test3": true}, received)
+	assert.NoError(t, err)
+}
+
 func Test_DeployMultipleConnector_Error(t *testing.T) {
-	client := &highLevelClient{client: &MockBaseClient{}, maxParallelRequest: 2}
+	mockBaseClient := &MockBaseClient{}
+	mockBaseClient.On("GetAllExpandedContext", mock.Anything, false, true).
+		Return(GetAllExpandedConnectorsResponse{}, errors.New("expand not supported"))
+
+	client := &highLevelClient{client: mockBaseClient, maxParallelRequest: 2}
 
 	// Don't want to mock every baseClient call, so I am going the lazy way.
-	patch := monkey.PatchInstanceMethod(reflect.TypeOf(client), "DeployConnector", func(_ *highLevelClient, req CreateConnectorRequest) (err error) {
+	patch := monkey.PatchInstanceMethod(reflect.TypeOf(client), "DeployConnectorContext", func(_ *highLevelClient, _ context.Context, req CreateConnectorRequest) (err error) {
 		return errors.New("random error")
 	})
-	defer patch.Restore()
+	defer patch.Unpatch()
 
 	err := client.DeployMultipleConnector([]CreateConnectorRequest{
 		{ConnectorRequest: ConnectorRequest{Name: "test1"}},
@@ -204,3 +307,195 @@ func Test_DeployMultipleConnector_Error(t *testing.T) {
 
 	assert.Error(t, err)
 }
+
+func Test_ValidateMultipleConnector_Error(t *testing.T) {
+	mockBaseClient := &MockBaseClient{}
+	mockBaseClient.On("ValidateConnectorConfigContext", mock.Anything, ValidateConnectorConfigRequest{
+		Class:  "FileStreamSource",
+		Config: map[string]interface{}{"name": "test1", "connector.class": "FileStreamSource"},
+	}).Return(ValidateConnectorConfigResponse{}, nil)
+	mockBaseClient.On("ValidateConnectorConfigContext", mock.Anything, ValidateConnectorConfigRequest{
+		Class:  "FileStreamSource",
+		Config: map[string]interface{}{"name": "test2", "connector.class": "FileStreamSource"},
+	}).Return(ValidateConnectorConfigResponse{
+		ErrorCount: 1,
+		Configs: []ConfigValidation{
+			{Value: ConfigValue{Name: "file", Errors: []string{"missing file"}}},
+		},
+	}, nil)
+
+	client := &highLevelClient{client: mockBaseClient}
+	err := client.ValidateMultipleConnector([]CreateConnectorRequest{
+		{ConnectorRequest: ConnectorRequest{Name: "test1"}, Config: map[string]interface{}{"connector.class": "FileStreamSource"}},
+		{ConnectorRequest: ConnectorRequest{Name: "test2"}, Config: map[string]interface{}{"connector.class": "FileStreamSource"}},
+		{ConnectorRequest: ConnectorRequest{Name: "test3"}, Config: map[string]interface{}{}},
+	})
+
+	assert.Error(t, err)
+	assert.Len(t, err.(*multierror.Error).Errors, 2)
+	assert.Contains(t, err.Error(), "missing file")
+	assert.Contains(t, err.Error(), "test3")
+	mockBaseClient.AssertExpectations(t)
+}
+
+func Test_ResetConnectorOffsets_Sync_Waits_For_Stopped(t *testing.T) {
+	mockBaseClient := &MockBaseClient{}
+	mockBaseClient.On("GetConnectorStatusContext", mock.Anything, ConnectorRequest{Name: "test1"}).
+		Return(GetConnectorStatusResponse{EmptyResponse: EmptyResponse{Code: 200}, ConnectorStatus: map[string]string{"state": "RUNNING"}}, nil).Once()
+	mockBaseClient.On("GetConnectorStatusContext", mock.Anything, ConnectorRequest{Name: "test1"}).
+		Return(GetConnectorStatusResponse{EmptyResponse: EmptyResponse{Code: 200}, ConnectorStatus: map[string]string{"state": "STOPPED"}}, nil).Once()
+	mockBaseClient.On("ResetConnectorOffsetsContext", mock.Anything, ConnectorRequest{Name: "test1"}).
+		Return(ConnectorOffsetsMessageResponse{EmptyResponse: EmptyResponse{Code: 200}}, nil)
+
+	client := &highLevelClient{client: mockBaseClient}
+	resp, err := client.ResetConnectorOffsets(ConnectorRequest{Name: "test1"}, true)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 200, resp.Code)
+	mockBaseClient.AssertExpectations(t)
+}
+
+func Test_SetDesiredState_When_Already_In_State(t *testing.T) {
+	mockBaseClient := &MockBaseClient{}
+	mockBaseClient.On("GetConnectorStatusContext", mock.Anything, ConnectorRequest{Name: "test1"}).
+		Return(GetConnectorStatusResponse{EmptyResponse: EmptyResponse{Code: 200}, ConnectorStatus: map[string]string{"state": StatePaused}}, nil)
+	// note we don't mock any transition because none should be called
+
+	client := &highLevelClient{client: mockBaseClient}
+	_, err := client.SetDesiredState(ConnectorRequest{Name: "test1"}, StatePaused, true)
+
+	assert.NoError(t, err)
+	mockBaseClient.AssertExpectations(t)
+}
+
+func Test_SetDesiredState_Resume_From_Stopped(t *testing.T) {
+	stopped := GetConnectorStatusResponse{EmptyResponse: EmptyResponse{Code: 200}, ConnectorStatus: map[string]string{"state": StateStopped}}
+	// expected steps:
+	// - get status to find the needed transition
+	// - get status again to know resume is from stopped
+	// - resume
+	// - connector is running, its task is listed but not recreated yet
+	// - connector and its tasks are running
+	mockBaseClient := &MockBaseClient{}
+	mockBaseClient.On("GetConnectorStatusContext", mock.Anything, ConnectorRequest{Name: "test1"}).Return(stopped, nil).Twice()
+	mockBaseClient.On("ResumeConnectorContext", mock.Anything, ConnectorRequest{Name: "test1"}).
+		Return(EmptyResponse{Code: 202}, nil)
+	mockBaseClient.On("GetConnectorContext", mock.Anything, ConnectorRequest{Name: "test1"}).
+		Return(ConnectorResponse{EmptyResponse: EmptyResponse{Code: 200}, Name: "test1", Tasks: []TaskID{{Connector: "test1", TaskID: 0}}}, nil)
+	mockBaseClient.On("GetConnectorStatusContext", mock.Anything, ConnectorRequest{Name: "test1"}).
+		Return(GetConnectorStatusResponse{EmptyResponse: EmptyResponse{Code: 200}, ConnectorStatus: map[string]string{"state": StateRunning}}, nil).Once()
+	mockBaseClient.On("GetConnectorStatusContext", mock.Anything, ConnectorRequest{Name: "test1"}).
+		Return(GetConnectorStatusResponse{
+			EmptyResponse:   EmptyResponse{Code: 200},
+			ConnectorStatus: map[string]string{"state": StateRunning},
+			TasksStatus:     []TaskStatus{{ID: 0, State: StateRunning}},
+		}, nil).Once()
+
+	client := &highLevelClient{client: mockBaseClient}
+	resp, err := client.SetDesiredState(ConnectorRequest{Name: "test1"}, StateRunning, true)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 202, resp.Code)
+	mockBaseClient.AssertExpectations(t)
+}
+
+func Test_SetDesiredState_Resume_From_Stopped_Without_Tasks(t *testing.T) {
+	stopped := GetConnectorStatusResponse{EmptyResponse: EmptyResponse{Code: 200}, ConnectorStatus: map[string]string{"state": StateStopped}}
+	mockBaseClient := &MockBaseClient{}
+	mockBaseClient.On("GetConnectorStatusContext", mock.Anything, ConnectorRequest{Name: "test1"}).Return(stopped, nil).Twice()
+	mockBaseClient.On("ResumeConnectorContext", mock.Anything, ConnectorRequest{Name: "test1"}).
+		Return(EmptyResponse{Code: 202}, nil)
+	// the connector generated no task config
+	mockBaseClient.On("GetConnectorContext", mock.Anything, ConnectorRequest{Name: "test1"}).
+		Return(ConnectorResponse{EmptyResponse: EmptyResponse{Code: 200}, Name: "test1", Tasks: []TaskID{}}, nil).Once()
+	mockBaseClient.On("GetConnectorStatusContext", mock.Anything, ConnectorRequest{Name: "test1"}).
+		Return(GetConnectorStatusResponse{EmptyResponse: EmptyResponse{Code: 200}, ConnectorStatus: map[string]string{"state": StateRunning}}, nil).Once()
+
+	client := &highLevelClient{client: mockBaseClient}
+	ctx := ContextWithWaitOptions(context.Background(), WaitOptions{Timeout: time.Second})
+	resp, err := client.SetDesiredStateContext(ctx, ConnectorRequest{Name: "test1"}, StateRunning, true)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 202, resp.Code)
+	mockBaseClient.AssertExpectations(t)
+}
+
+func Test_RestartConnectorWithOptions_Sync_Reports_Failed_Tasks(t *testing.T) {
+	req := RestartConnectorRequest{ConnectorRequest: ConnectorRequest{Name: "test1"}, IncludeTasks: true, OnlyFailed: true}
+
+	mockBaseClient := &MockBaseClient{}
+	mockBaseClient.On("RestartConnectorWithOptionsContext", mock.Anything, req).
+		Return(RestartConnectorResponse{GetConnectorStatusResponse: GetConnectorStatusResponse{
+			EmptyResponse:   EmptyResponse{Code: 202},
+			ConnectorStatus: map[string]string{"state": StateRunning},
+			TasksStatus:     []TaskStatus{{ID: 0, State: StateRunning}, {ID: 1, State: StateRestarting}, {ID: 2, State: StateRestarting}},
+		}}, nil)
+	mockBaseClient.On("GetConnectorStatusContext", mock.Anything, ConnectorRequest{Name: "test1"}).
+		Return(GetConnectorStatusResponse{
+			EmptyResponse:   EmptyResponse{Code: 200},
+			ConnectorStatus: map[string]string{"state": StateRunning},
+			TasksStatus:     []TaskStatus{{ID: 0, State: StateRunning}, {ID: 1, State: StateRunning}, {ID: 2, State: StateUnassigned}},
+		}, nil).Once()
+	mockBaseClient.On("GetConnectorStatusContext", mock.Anything, ConnectorRequest{Name: "test1"}).
+		Return(GetConnectorStatusResponse{
+			EmptyResponse:   EmptyResponse{Code: 200},
+			ConnectorStatus: map[string]string{"state": StateRunning},
+			TasksStatus:     []TaskStatus{{ID: 0, State: StateRunning}, {ID: 1, State: StateRunning}, {ID: 2, State: StateFailed, Trace: "boom"}},
+		}, nil).Once()
+
+	client := &highLevelClient{client: mockBaseClient}
+	resp, err := client.RestartConnectorWithOptions(req, true)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []TaskStatus{{ID: 2, State: StateFailed, Trace: "boom"}}, resp.FailedTasks)
+	mockBaseClient.AssertExpectations(t)
+}
+
+func Test_GetAllTopics(t *testing.T) {
+	mockBaseClient := &MockBaseClient{}
+	mockBaseClient.On("GetAllContext", mock.Anything).
+		Return(GetAllConnectorsResponse{Connectors: []string{"test1", "test2", "test3"}}, nil)
+	mockBaseClient.On("GetConnectorTopicsContext", mock.Anything, ConnectorRequest{Name: "test1"}).
+		Return(GetConnectorTopicsResponse{Topics: []string{"topic1", "topic2"}}, nil)
+	mockBaseClient.On("GetConnectorTopicsContext", mock.Anything, ConnectorRequest{Name: "test2"}).
+		Return(GetConnectorTopicsResponse{Topics: []string{"topic2"}}, nil)
+	mockBaseClient.On("GetConnectorTopicsContext", mock.Anything, ConnectorRequest{Name: "test3"}).
+		Return(GetConnectorTopicsResponse{}, nil)
+
+	client := &highLevelClient{client: mockBaseClient, maxParallelRequest: 2}
+	resp, err := client.GetAllTopics()
+
+	assert.NoError(t, err)
+	assert.Equal(t, map[string][]string{
+		"topic1": {"test1"},
+		"topic2": {"test1", "test2"},
+	}, resp.Topics)
+}
+
+func Test_SetLogLevelTemporarily_Restores_Previous_Level(t *testing.T) {
+	logger := LoggerRequest{Name: "org.apache.kafka.connect"}
+
+	mockBaseClient := &MockBaseClient{}
+	mockBaseClient.On("GetLoggerContext", mock.Anything, logger).
+		Return(GetLoggerResponse{LoggerLevel: LoggerLevel{Level: "INFO"}}, nil)
+	mockBaseClient.On("SetLogLevelContext", mock.Anything, SetLogLevelRequest{LoggerRequest: logger, Level: "DEBUG", Scope: LogScopeCluster}).
+		Return(SetLogLevelResponse{EmptyResponse: EmptyResponse{Code: 204}}, nil).Once()
+	mockBaseClient.On("SetLogLevelContext", mock.Anything, SetLogLevelRequest{LoggerRequest: logger, Level: "INFO", Scope: LogScopeCluster}).
+		Return(SetLogLevelResponse{EmptyResponse: EmptyResponse{Code: 204}}, nil).Once()
+
+	client := &highLevelClient{client: mockBaseClient}
+	temporary, err := client.SetLogLevelTemporarily(SetLogLevelRequest{LoggerRequest: logger, Level: "DEBUG", Scope: LogScopeCluster}, 50*time.Millisecond)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "INFO", temporary.PreviousLevel)
+
+	select {
+	case err := <-temporary.Done():
+		assert.NoError(t, err)
+	case <-time.After(time.Second):
+		assert.Fail(t, "log level was not restored")
+	}
+	// restoring again has no effect
+	assert.NoError(t, temporary.Restore())
+	mockBaseClient.AssertExpectations(t)
+}
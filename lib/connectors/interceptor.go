@@ -0,0 +1,189 @@
+package connectors
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/pkg/errors"
+	"gopkg.in/resty.v1"
+)
+
+//RequestInfo describes a request about to be sent to kafka-connect, see Interceptor
+type RequestInfo struct {
+	// Operation is the client method sending the request, e.g. "PauseConnector"
+	Operation string
+	// Connector is the name of the connector the request is about, empty for other requests
+	Connector string
+	// Endpoint is the path of the endpoint, before connector names and such are set, e.g. "connectors/{name}/pause"
+	Endpoint string
+	// Attempt starts at 1, and is incremented every time the request is retried
+	Attempt int
+	// Request is the HTTP request, BeforeRequest can change it, or replace it e.g. to add values to its context
+	Request *http.Request
+}
+
+//Method returns the HTTP method of the request
+func (r *RequestInfo) Method() string {
+	return r.Request.Method
+}
+
+//URL returns the url the request is sent to
+func (r *RequestInfo) URL() string {
+	return r.Request.URL.String()
+}
+
+//ResponseInfo describes the outcome of a request sent to kafka-connect, see Interceptor
+type ResponseInfo struct {
+	RequestInfo
+	// Response is nil when no response was received
+	Response *http.Response
+	Latency  time.Duration
+	Err      error
+}
+
+//StatusCode returns the status of the response, 0 when no response was received
+func (r *ResponseInfo) StatusCode() int {
+	if r.Response == nil {
+		return 0
+	}
+	return r.Response.StatusCode
+}
+
+//Interceptor is called around every attempt of every request sent to kafka-connect, e.g. to add correlation ids,
+//sign requests or record audit data
+type Interceptor interface {
+	//BeforeRequest is called before sending the request, an error aborts it and is returned by the client
+	BeforeRequest(req *RequestInfo) error
+	//AfterResponse is called once the request was sent, or failed, whenever BeforeRequest was called
+	AfterResponse(resp *ResponseInfo)
+}
+
+//OperationInterceptor can be implemented by an Interceptor to also be called around HighLevelClient operations,
+//e.g. DeployConnector, and sync waits, so that it sees the requests they send as a whole, e.g. to trace them
+type OperationInterceptor interface {
+	//StartOperation is called when an operation starts, requests it sends are bound to the returned context
+	//end is called with the error of the operation once it is over
+	StartOperation(ctx context.Context, operation string, connector string) (_ context.Context, end func(err error))
+}
+
+//InterceptorFuncs is an Interceptor made of functions, any of them can be nil
+type InterceptorFuncs struct {
+	Before func(req *RequestInfo) error
+	After  func(resp *ResponseInfo)
+}
+
+//BeforeRequest implements Interceptor
+func (f InterceptorFuncs) BeforeRequest(req *RequestInfo) error {
+	if f.Before == nil {
+		return nil
+	}
+	return f.Before(req)
+}
+
+//AfterResponse implements Interceptor
+func (f InterceptorFuncs) AfterResponse(resp *ResponseInfo) {
+	if f.After != nil {
+		f.After(resp)
+	}
+}
+
+type interceptorChain []Interceptor
+
+//ChainInterceptors returns an Interceptor calling the given ones, BeforeRequest in order and AfterResponse in reverse order,
+//so that the first interceptor wraps all others. It is also an OperationInterceptor calling the given ones which are
+func ChainInterceptors(interceptors ...Interceptor) Interceptor {
+	return interceptorChain(interceptors)
+}
+
+var _ OperationInterceptor = interceptorChain{}
+
+func (chain interceptorChain) BeforeRequest(req *RequestInfo) error {
+	for _, interceptor := range chain {
+		if err := interceptor.BeforeRequest(req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (chain interceptorChain) AfterResponse(resp *ResponseInfo) {
+	for i := len(chain) - 1; i >= 0; i-- {
+		chain[i].AfterResponse(resp)
+	}
+}
+
+func (chain interceptorChain) StartOperation(ctx context.Context, operation string, connector string) (context.Context, func(err error)) {
+	var ends []func(err error)
+	for _, interceptor := range operationInterceptors(chain) {
+		var end func(err error)
+		ctx, end = interceptor.StartOperation(ctx, operation, connector)
+		ends = append(ends, end)
+	}
+	return ctx, func(err error) {
+		for i := len(ends) - 1; i >= 0; i-- {
+			ends[i](err)
+		}
+	}
+}
+
+// operationInterceptors returns the interceptors which are OperationInterceptors
+func operationInterceptors(interceptors []Interceptor) []OperationInterceptor {
+	var result []OperationInterceptor
+	for _, interceptor := range interceptors {
+		if operationInterceptor, ok := interceptor.(OperationInterceptor); ok {
+			result = append(result, operationInterceptor)
+		}
+	}
+	return result
+}
+
+//AddInterceptor registers interceptors called around every request, after the ones already registered
+func (c *baseClient) AddInterceptor(interceptors ...Interceptor) {
+	c.interceptors = append(c.interceptors, interceptors...)
+}
+
+// call is what the client knows about the request being sent, it travels with the request context
+type call struct {
+	operation string
+	connector string
+
+	// set by beforeRequest, once the HTTP request is built
+	info           *RequestInfo
+	interceptorErr error
+}
+
+type callKey struct{}
+
+func callFromContext(ctx context.Context) *call {
+	current, _ := ctx.Value(callKey{}).(*call)
+	return current
+}
+
+// beforeRequest is the pre-request hook of the rest client, it runs interceptors on the HTTP request
+func (c *baseClient) beforeRequest(_ *resty.Client, request *resty.Request) error {
+	current := callFromContext(request.Context())
+	// info is only set by execute, a request sent otherwise has nothing to tell interceptors
+	if current == nil || current.info == nil || len(c.interceptors) == 0 {
+		return nil
+	}
+	current.info.Request = request.RawRequest
+	if err := interceptorChain(c.interceptors).BeforeRequest(current.info); err != nil {
+		current.interceptorErr = errors.Wrap(err, "interceptor")
+		return current.interceptorErr
+	}
+	request.RawRequest = current.info.Request
+	return nil
+}
+
+// afterResponse runs interceptors once an attempt is over, if they were called before it
+func (c *baseClient) afterResponse(current *call, resp *resty.Response, err error, latency time.Duration) {
+	if current == nil || current.info == nil || current.info.Request == nil {
+		return
+	}
+	info := &ResponseInfo{RequestInfo: *current.info, Latency: latency, Err: err}
+	if resp != nil {
+		info.Response = resp.RawResponse
+	}
+	interceptorChain(c.interceptors).AfterResponse(info)
+}
@@ -0,0 +1,177 @@
+//go:build !integration
+
+package connectors
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/jarcoal/httpmock.v1"
+)
+
+func Test_Interceptor_Around_Every_Attempt(t *testing.T) {
+	transport := httpmock.NewMockTransport()
+	attempts := 0
+	transport.RegisterResponder("PUT", "http://randomurl/connectors/test/pause", func(req *http.Request) (*http.Response, error) {
+		assert.Equal(t, "correlation-id", req.Header.Get("X-Correlation-Id"))
+		attempts++
+		if attempts == 1 {
+			return httpmock.NewStringResponse(503, ""), nil
+		}
+		return httpmock.NewStringResponse(202, ""), nil
+	})
+	client, err := NewClientWithOptions("http://randomurl",
+		WithTransport(transport),
+		WithRetry(1, 0, 0),
+	)
+	assert.NoError(t, err)
+
+	var calls []string
+	var responses []ResponseInfo
+	client.AddInterceptor(
+		InterceptorFuncs{
+			Before: func(req *RequestInfo) error {
+				calls = append(calls, "before 1")
+				req.Request.Header.Set("X-Correlation-Id", "correlation-id")
+				return nil
+			},
+			After: func(resp *ResponseInfo) {
+				calls = append(calls, "after 1")
+				responses = append(responses, *resp)
+			},
+		},
+		InterceptorFuncs{
+			Before: func(req *RequestInfo) error {
+				calls = append(calls, "before 2")
+				return nil
+			},
+			After: func(resp *ResponseInfo) {
+				calls = append(calls, "after 2")
+			},
+		},
+	)
+
+	_, err = client.PauseConnector(ConnectorRequest{Name: "test"}, false)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"before 1", "before 2", "after 2", "after 1", "before 1", "before 2", "after 2", "after 1"}, calls)
+	if assert.Len(t, responses, 2) {
+		assert.Equal(t, "PauseConnector", responses[1].Operation)
+		assert.Equal(t, "test", responses[1].Connector)
+		assert.Equal(t, "PUT", responses[1].Method())
+		assert.Equal(t, "http://randomurl/connectors/test/pause", responses[1].URL())
+		assert.Equal(t, 2, responses[1].Attempt)
+		assert.Equal(t, 503, responses[0].StatusCode())
+		assert.Equal(t, 202, responses[1].StatusCode())
+		assert.True(t, responses[1].Latency > 0)
+	}
+}
+
+func Test_Interceptor_Abort_Request(t *testing.T) {
+	transport := httpmock.NewMockTransport()
+	client, err := NewClientWithOptions("http://randomurl",
+		WithTransport(transport),
+		WithInterceptor(InterceptorFuncs{
+			Before: func(req *RequestInfo) error {
+				return errors.New("could not sign request")
+			},
+		}),
+	)
+	assert.NoError(t, err)
+
+	_, err = client.GetAll()
+
+	assert.EqualError(t, err, "interceptor: could not sign request")
+	assert.Equal(t, 0, transport.GetTotalCallCount())
+}
+
+func Test_Interceptor_Skips_Requests_Not_Executed(t *testing.T) {
+	transport := httpmock.NewMockTransport()
+	transport.RegisterResponder("GET", "http://randomurl/connectors", httpmock.NewStringResponder(200, "[]"))
+	called := false
+	client, err := newBaseClientWithOptions("http://randomurl", clientOptions{
+		transport: transport,
+		interceptors: []Interceptor{InterceptorFuncs{Before: func(req *RequestInfo) error {
+			called = true
+			return nil
+		}}},
+	})
+	assert.NoError(t, err)
+
+	resp, err := client.newRequest(context.Background(), "GetAll", "").Get("connectors")
+
+	assert.NoError(t, err)
+	assert.Equal(t, 200, resp.StatusCode())
+	assert.False(t, called)
+}
+
+type operationRecorder struct {
+	calls []string
+}
+
+type recordedOperationKey struct{}
+
+func (r *operationRecorder) StartOperation(ctx context.Context, operation string, connector string) (context.Context, func(err error)) {
+	r.calls = append(r.calls, "start "+operation+" "+connector)
+	return context.WithValue(ctx, recordedOperationKey{}, operation), func(err error) {
+		r.calls = append(r.calls, fmt.Sprintf("end %v: %v", operation, err))
+	}
+}
+
+func (r *operationRecorder) BeforeRequest(req *RequestInfo) error {
+	r.calls = append(r.calls, fmt.Sprintf("request %v in %v", req.Operation, req.Request.Context().Value(recordedOperationKey{})))
+	return nil
+}
+
+func (r *operationRecorder) AfterResponse(resp *ResponseInfo) {}
+
+func Test_OperationInterceptor(t *testing.T) {
+	transport := httpmock.NewMockTransport()
+	transport.RegisterResponder("PUT", "http://randomurl/connectors/test/pause", httpmock.NewStringResponder(202, ""))
+	transport.RegisterResponder("GET", "http://randomurl/connectors/test/status",
+		httpmock.NewJsonResponderOrPanic(200, GetConnectorStatusResponse{Name: "test", ConnectorStatus: map[string]string{"state": StatePaused}}))
+	recorder := &operationRecorder{}
+	client, err := NewClientWithOptions("http://randomurl",
+		WithTransport(transport),
+		WithInterceptor(recorder),
+	)
+	assert.NoError(t, err)
+
+	_, err = client.PauseConnector(ConnectorRequest{Name: "test"}, true)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{
+		"start PauseConnector test",
+		"request PauseConnector in PauseConnector",
+		"start wait pausing connector ",
+		"request GetConnectorStatus in wait pausing connector",
+		"end wait pausing connector: <nil>",
+		"end PauseConnector: <nil>",
+	}, recorder.calls)
+}
+
+func Test_OperationInterceptor_In_Chain(t *testing.T) {
+	transport := httpmock.NewMockTransport()
+	transport.RegisterResponder("PUT", "http://randomurl/connectors/test/pause", httpmock.NewStringResponder(202, ""))
+	first, second := &operationRecorder{}, &operationRecorder{}
+	client, err := NewClientWithOptions("http://randomurl",
+		WithTransport(transport),
+		WithInterceptor(ChainInterceptors(first, InterceptorFuncs{}, ChainInterceptors(second))),
+	)
+	assert.NoError(t, err)
+
+	_, err = client.PauseConnector(ConnectorRequest{Name: "test"}, false)
+
+	assert.NoError(t, err)
+	expected := []string{
+		"start PauseConnector test",
+		"request PauseConnector in PauseConnector",
+		"end PauseConnector: <nil>",
+	}
+	assert.Equal(t, expected, first.calls)
+	assert.Equal(t, expected, second.calls)
+}
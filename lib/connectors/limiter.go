@@ -0,0 +1,109 @@
+package connectors
+
+import (
+	"context"
+	"sync"
+
+	"github.com/pkg/errors"
+	"golang.org/x/time/rate"
+)
+
+//WithRateLimit limits the rate of requests sent by the client, shared by every goroutine using it, see SetRateLimit
+func WithRateLimit(requestsPerSecond float64, burst int) Option {
+	return func(o *clientOptions) error {
+		if requestsPerSecond < 0 {
+			return errors.Errorf("invalid rate limit: %v", requestsPerSecond)
+		}
+		o.rateLimit = requestsPerSecond
+		o.rateBurst = burst
+		return nil
+	}
+}
+
+//WithMaxInFlight limits the number of requests waiting for an answer at once, shared by every goroutine using the client,
+//see SetMaxInFlight
+func WithMaxInFlight(max int) Option {
+	return func(o *clientOptions) error {
+		if max < 0 {
+			return errors.Errorf("invalid max in flight requests: %v", max)
+		}
+		o.maxInFlight = max
+		return nil
+	}
+}
+
+// requestLimiter throttles requests of a client, it lets everything through unless limits are set
+type requestLimiter struct {
+	lock     sync.RWMutex
+	rate     *rate.Limiter
+	inFlight chan struct{}
+}
+
+func newRequestLimiter(requestsPerSecond float64, burst int, maxInFlight int) *requestLimiter {
+	limiter := &requestLimiter{}
+	limiter.setRate(requestsPerSecond, burst)
+	limiter.setMaxInFlight(maxInFlight)
+	return limiter
+}
+
+// setRate sets a token bucket refilled with requestsPerSecond tokens per second, holding up to burst tokens
+// A rate of 0 removes the limit
+func (l *requestLimiter) setRate(requestsPerSecond float64, burst int) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	if requestsPerSecond <= 0 {
+		l.rate = nil
+		return
+	}
+	if burst < 1 {
+		burst = 1
+	}
+	l.rate = rate.NewLimiter(rate.Limit(requestsPerSecond), burst)
+}
+
+// setMaxInFlight sets the number of requests sent at once, 0 removes the limit
+// Requests already sent keep the slot they got from the previous limit
+func (l *requestLimiter) setMaxInFlight(max int) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	if max <= 0 {
+		l.inFlight = nil
+		return
+	}
+	l.inFlight = make(chan struct{}, max)
+}
+
+// acquire waits until a request can be sent, release must be called once it is answered
+func (l *requestLimiter) acquire(ctx context.Context) (release func(), err error) {
+	l.lock.RLock()
+	limiter, inFlight := l.rate, l.inFlight
+	l.lock.RUnlock()
+
+	if limiter != nil {
+		if err := limiter.Wait(ctx); err != nil {
+			return nil, errors.Wrap(err, "could not wait for rate limit")
+		}
+	}
+	if inFlight == nil {
+		return func() {}, nil
+	}
+	select {
+	case inFlight <- struct{}{}:
+		return func() { <-inFlight }, nil
+	case <-ctx.Done():
+		return nil, errors.Wrap(ctx.Err(), "could not wait for a request slot")
+	}
+}
+
+//SetRateLimit limits the rate of requests sent by the client with a token bucket, shared by every goroutine using it,
+//including sync waits and retries. burst is the number of requests which can be sent at once after a quiet period.
+//A rate of 0 removes the limit, which is the default
+func (c *baseClient) SetRateLimit(requestsPerSecond float64, burst int) {
+	c.limiter.setRate(requestsPerSecond, burst)
+}
+
+//SetMaxInFlight limits the number of requests waiting for an answer at once, shared by every goroutine using the client
+//0 removes the limit, which is the default
+func (c *baseClient) SetMaxInFlight(max int) {
+	c.limiter.setMaxInFlight(max)
+}
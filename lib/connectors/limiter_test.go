@@ -0,0 +1,82 @@
+//go:build !integration
+
+package connectors
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_MaxInFlight_Shared_Across_Goroutines(t *testing.T) {
+	var inFlight, maxInFlight int32
+	transport := roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		current := atomic.AddInt32(&inFlight, 1)
+		defer atomic.AddInt32(&inFlight, -1)
+		for {
+			max := atomic.LoadInt32(&maxInFlight)
+			if current <= max || atomic.CompareAndSwapInt32(&maxInFlight, max, current) {
+				break
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+		return &http.Response{
+			StatusCode: 200,
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+			Body:       ioutil.NopCloser(bytes.NewBufferString(`[]`)),
+			Request:    req,
+		}, nil
+	})
+	client, err := NewClientWithOptions("http://randomurl", WithTransport(transport), WithMaxInFlight(2))
+	assert.NoError(t, err)
+
+	wg := sync.WaitGroup{}
+	for i := 0; i < 6; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := client.GetAll()
+			assert.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, int32(2), maxInFlight)
+}
+
+func Test_RateLimit(t *testing.T) {
+	transport := roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		return &http.Response{
+			StatusCode: 200,
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+			Body:       ioutil.NopCloser(bytes.NewBufferString(`[]`)),
+			Request:    req,
+		}, nil
+	})
+	client, err := NewClientWithOptions("http://randomurl", WithTransport(transport))
+	assert.NoError(t, err)
+	client.SetRateLimit(20, 2)
+
+	start := time.Now()
+	for i := 0; i < 4; i++ {
+		_, err := client.GetAll()
+		assert.NoError(t, err)
+	}
+
+	// 2 requests are let through by the burst, next ones wait 50ms each
+	assert.True(t, time.Since(start) >= 90*time.Millisecond)
+}
+
+func Test_Invalid_Limits(t *testing.T) {
+	_, err := NewClientWithOptions("http://randomurl", WithRateLimit(-1, 1))
+	assert.Error(t, err)
+
+	_, err = NewClientWithOptions("http://randomurl", WithMaxInFlight(-1))
+	assert.Error(t, err)
+}
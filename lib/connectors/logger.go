@@ -0,0 +1,183 @@
+package connectors
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+)
+
+//Logger receives leveled, structured events of the client: retries, sync waits, deploy decisions and failures
+//keysAndValues alternate keys and values, as with log/slog, which *slog.Logger implements, see NewSlogLogger
+//Secrets in headers and config diffs are redacted before being logged
+type Logger interface {
+	Debug(msg string, keysAndValues ...interface{})
+	Info(msg string, keysAndValues ...interface{})
+	Warn(msg string, keysAndValues ...interface{})
+	Error(msg string, keysAndValues ...interface{})
+}
+
+//LogLevel is the minimum level of events logged by a logger from NewStdLogger
+type LogLevel int
+
+const (
+	LogLevelDebug LogLevel = iota
+	LogLevelInfo
+	LogLevelWarn
+	LogLevelError
+)
+
+func (l LogLevel) String() string {
+	switch l {
+	case LogLevelDebug:
+		return "DEBUG"
+	case LogLevelInfo:
+		return "INFO"
+	case LogLevelWarn:
+		return "WARN"
+	default:
+		return "ERROR"
+	}
+}
+
+type nopLogger struct{}
+
+func (nopLogger) Debug(string, ...interface{}) {}
+func (nopLogger) Info(string, ...interface{})  {}
+func (nopLogger) Warn(string, ...interface{})  {}
+func (nopLogger) Error(string, ...interface{}) {}
+
+type stdLogger struct {
+	logger *log.Logger
+	level  LogLevel
+}
+
+//NewStdLogger returns a Logger writing events of at least the given level to a log.Logger, as "LEVEL msg key=value..."
+//It uses the standard logger if logger is nil
+func NewStdLogger(logger *log.Logger, level LogLevel) Logger {
+	if logger == nil {
+		logger = log.Default()
+	}
+	return &stdLogger{logger: logger, level: level}
+}
+
+func (l *stdLogger) Debug(msg string, keysAndValues ...interface{}) {
+	l.log(LogLevelDebug, msg, keysAndValues)
+}
+
+func (l *stdLogger) Info(msg string, keysAndValues ...interface{}) {
+	l.log(LogLevelInfo, msg, keysAndValues)
+}
+
+func (l *stdLogger) Warn(msg string, keysAndValues ...interface{}) {
+	l.log(LogLevelWarn, msg, keysAndValues)
+}
+
+func (l *stdLogger) Error(msg string, keysAndValues ...interface{}) {
+	l.log(LogLevelError, msg, keysAndValues)
+}
+
+func (l *stdLogger) log(level LogLevel, msg string, keysAndValues []interface{}) {
+	if level < l.level {
+		return
+	}
+	line := &strings.Builder{}
+	fmt.Fprintf(line, "%s %s", level, msg)
+	for i := 0; i < len(keysAndValues); i += 2 {
+		if i+1 < len(keysAndValues) {
+			fmt.Fprintf(line, " %v=%v", keysAndValues[i], formatLogValue(keysAndValues[i+1]))
+		} else {
+			fmt.Fprintf(line, " %v", keysAndValues[i])
+		}
+	}
+	l.logger.Print(line.String())
+}
+
+// formatLogValue quotes values which would make a line ambiguous
+func formatLogValue(value interface{}) string {
+	formatted := fmt.Sprintf("%v", value)
+	if formatted == "" || strings.ContainsAny(formatted, " =\"\n") {
+		return fmt.Sprintf("%q", formatted)
+	}
+	return formatted
+}
+
+//SetLogger sets the logger receiving events of the client, nothing is logged by default
+func (c *baseClient) SetLogger(logger Logger) {
+	c.logger = logger
+}
+
+func (c *baseClient) log() Logger {
+	if c.logger == nil {
+		return nopLogger{}
+	}
+	return c.logger
+}
+
+// ------------- redaction ------------
+
+const redacted = "[REDACTED]"
+
+// secretHeaders always carry credentials
+var secretHeaders = map[string]bool{
+	"Authorization":       true,
+	"Proxy-Authorization": true,
+	"Cookie":              true,
+	"Set-Cookie":          true,
+}
+
+// secretKeyParts are parts of config keys and header names which carry credentials, e.g. "ssl.keystore.password"
+var secretKeyParts = []string{"password", "secret", "token", "credential", "apikey", "api.key", "api-key", "jaas.config", "private.key", "private-key"}
+
+func isSecretKey(key string) bool {
+	key = strings.ToLower(key)
+	for _, part := range secretKeyParts {
+		if strings.Contains(key, part) {
+			return true
+		}
+	}
+	return false
+}
+
+// redactHeaders returns a copy of headers in which values of credentials are replaced
+func redactHeaders(headers http.Header) http.Header {
+	result := make(http.Header, len(headers))
+	for name, values := range headers {
+		if secretHeaders[http.CanonicalHeaderKey(name)] || isSecretKey(name) {
+			result[name] = []string{redacted}
+			continue
+		}
+		result[name] = values
+	}
+	return result
+}
+
+// redactConfig returns a copy of a connector config in which values of credentials are replaced
+func redactConfig(config map[string]interface{}) map[string]interface{} {
+	if config == nil {
+		return nil
+	}
+	result := make(map[string]interface{}, len(config))
+	for key, value := range config {
+		if isSecretKey(key) {
+			result[key] = redacted
+			continue
+		}
+		result[key] = value
+	}
+	return result
+}
+
+// redactState returns a copy of a state kept by WaitTimeoutError in which values of credentials in configs are replaced
+func redactState(state interface{}) interface{} {
+	switch state := state.(type) {
+	case ConnectorResponse:
+		state.Config = redactConfig(state.Config)
+		return state
+	case GetConnectorConfigResponse:
+		state.Config = redactConfig(state.Config)
+		return state
+	default:
+		return state
+	}
+}
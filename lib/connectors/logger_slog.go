@@ -0,0 +1,46 @@
+//go:build go1.21
+
+package connectors
+
+import (
+	"context"
+	"log/slog"
+)
+
+type slogLogger struct {
+	logger *slog.Logger
+}
+
+//NewSlogLogger returns a Logger writing events to a log/slog logger, the default one if logger is nil
+func NewSlogLogger(logger *slog.Logger) Logger {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &slogLogger{logger: logger}
+}
+
+func (l *slogLogger) Debug(msg string, keysAndValues ...interface{}) {
+	l.log(slog.LevelDebug, msg, keysAndValues)
+}
+
+func (l *slogLogger) Info(msg string, keysAndValues ...interface{}) {
+	l.log(slog.LevelInfo, msg, keysAndValues)
+}
+
+func (l *slogLogger) Warn(msg string, keysAndValues ...interface{}) {
+	l.log(slog.LevelWarn, msg, keysAndValues)
+}
+
+func (l *slogLogger) Error(msg string, keysAndValues ...interface{}) {
+	l.log(slog.LevelError, msg, keysAndValues)
+}
+
+func (l *slogLogger) log(level slog.Level, msg string, keysAndValues []interface{}) {
+	// errors are logged as their message, rather than as an empty JSON object by JSON handlers
+	for i := 1; i < len(keysAndValues); i += 2 {
+		if err, ok := keysAndValues[i].(error); ok {
+			keysAndValues[i] = err.Error()
+		}
+	}
+	l.logger.Log(context.Background(), level, msg, keysAndValues...)
+}
@@ -0,0 +1,27 @@
+//go:build go1.21 && !integration
+
+package connectors
+
+import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_SlogLogger(t *testing.T) {
+	out := &bytes.Buffer{}
+	logger := NewSlogLogger(slog.New(slog.NewJSONHandler(out, nil)))
+
+	logger.Error("operation failed", "operation", "DeployConnector", "error", errors.New("random error"))
+
+	var event map[string]interface{}
+	assert.NoError(t, json.Unmarshal(out.Bytes(), &event))
+	assert.Equal(t, "ERROR", event["level"])
+	assert.Equal(t, "operation failed", event["msg"])
+	assert.Equal(t, "DeployConnector", event["operation"])
+	assert.Equal(t, "random error", event["error"])
+}
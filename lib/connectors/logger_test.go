@@ -0,0 +1,140 @@
+//go:build !integration
+
+package connectors
+
+import (
+	"bytes"
+	"log"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/jarcoal/httpmock.v1"
+)
+
+type loggedEvent struct {
+	level  string
+	msg    string
+	fields map[string]interface{}
+}
+
+type recordingLogger struct {
+	lock   sync.Mutex
+	events []loggedEvent
+}
+
+func (l *recordingLogger) record(level string, msg string, keysAndValues []interface{}) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	fields := map[string]interface{}{}
+	for i := 0; i+1 < len(keysAndValues); i += 2 {
+		fields[keysAndValues[i].(string)] = keysAndValues[i+1]
+	}
+	l.events = append(l.events, loggedEvent{level: level, msg: msg, fields: fields})
+}
+
+func (l *recordingLogger) Debug(msg string, keysAndValues ...interface{}) {
+	l.record("debug", msg, keysAndValues)
+}
+
+func (l *recordingLogger) Info(msg string, keysAndValues ...interface{}) {
+	l.record("info", msg, keysAndValues)
+}
+
+func (l *recordingLogger) Warn(msg string, keysAndValues ...interface{}) {
+	l.record("warn", msg, keysAndValues)
+}
+
+func (l *recordingLogger) Error(msg string, keysAndValues ...interface{}) {
+	l.record("error", msg, keysAndValues)
+}
+
+func (l *recordingLogger) find(level string, msg string) []loggedEvent {
+	var found []loggedEvent
+	for _, event := range l.events {
+		if event.level == level && event.msg == msg {
+			found = append(found, event)
+		}
+	}
+	return found
+}
+
+func Test_Logger_Deploy_Up_To_Date(t *testing.T) {
+	transport := httpmock.NewMockTransport()
+	transport.RegisterResponder("GET", "http://randomurl/connectors/test", httpmock.NewJsonResponderOrPanic(200, ConnectorResponse{Name: "test"}))
+	transport.RegisterResponder("GET", "http://randomurl/connectors/test/config", httpmock.NewJsonResponderOrPanic(200, map[string]string{"name": "test", "key": "value"}))
+	logger := &recordingLogger{}
+	client, err := NewClientWithOptions("http://randomurl", WithTransport(transport), WithLogger(logger))
+	assert.NoError(t, err)
+
+	err = client.DeployConnector(CreateConnectorRequest{
+		ConnectorRequest: ConnectorRequest{Name: "test"},
+		Config:           map[string]interface{}{"key": "value"},
+	})
+
+	assert.NoError(t, err)
+	if skipped := logger.find("info", "connector up to date, skipping"); assert.Len(t, skipped, 1) {
+		assert.Equal(t, "test", skipped[0].fields["connector"])
+	}
+	assert.Len(t, logger.find("debug", "request sent"), 2)
+}
+
+func Test_Logger_Retry_And_Failure(t *testing.T) {
+	transport := httpmock.NewMockTransport()
+	transport.RegisterResponder("PUT", "http://randomurl/connectors/test/config", httpmock.NewStringResponder(503, ""))
+	logger := &recordingLogger{}
+	client, err := NewClientWithOptions("http://randomurl", WithTransport(transport), WithRetry(1, 0, 0))
+	assert.NoError(t, err)
+	client.SetLogger(logger)
+	client.SetTokenSource(StaticToken("my-token"))
+
+	_, err = client.UpdateConnector(CreateConnectorRequest{
+		ConnectorRequest: ConnectorRequest{Name: "test"},
+		Config:           map[string]interface{}{"connection.password": "secret"},
+	}, true)
+
+	assert.Error(t, err)
+	if retries := logger.find("warn", "retrying request"); assert.Len(t, retries, 1) {
+		assert.Equal(t, "UpdateConnector", retries[0].fields["operation"])
+		assert.Equal(t, 503, retries[0].fields["status"])
+		assert.Equal(t, []string{"[REDACTED]"}, retries[0].fields["headers"].(http.Header)["Authorization"])
+	}
+	assert.Len(t, logger.find("error", "operation failed"), 1)
+}
+
+func Test_Redact(t *testing.T) {
+	headers := redactHeaders(http.Header{
+		"Authorization": {"Bearer token"},
+		"X-Api-Key":     {"key"},
+		"Accept":        {"application/json"},
+	})
+	assert.Equal(t, http.Header{
+		"Authorization": {"[REDACTED]"},
+		"X-Api-Key":     {"[REDACTED]"},
+		"Accept":        {"application/json"},
+	}, headers)
+
+	config := redactConfig(map[string]interface{}{
+		"connection.password":   "secret",
+		"sasl.jaas.config":      "org.apache.kafka.common.security.plain.PlainLoginModule required password=secret;",
+		"key.converter":         "org.apache.kafka.connect.json.JsonConverter",
+		"ssl.keystore.password": "secret",
+	})
+	assert.Equal(t, map[string]interface{}{
+		"connection.password":   "[REDACTED]",
+		"sasl.jaas.config":      "[REDACTED]",
+		"key.converter":         "org.apache.kafka.connect.json.JsonConverter",
+		"ssl.keystore.password": "[REDACTED]",
+	}, config)
+}
+
+func Test_StdLogger(t *testing.T) {
+	out := &bytes.Buffer{}
+	logger := NewStdLogger(log.New(out, "", 0), LogLevelInfo)
+
+	logger.Debug("hidden")
+	logger.Info("connector up to date, skipping", "connector", "my connector")
+
+	assert.Equal(t, "INFO connector up to date, skipping connector=\"my connector\"\n", out.String())
+}
@@ -4,6 +4,7 @@
 
 package connectors
 
+import context "context"
 import mock "github.com/stretchr/testify/mock"
 import tls "crypto/tls"
 
@@ -12,6 +13,59 @@ type MockBaseClient struct {
 	mock.Mock
 }
 
+// AddInterceptor provides a mock function with given fields: interceptors
+func (_m *MockBaseClient) AddInterceptor(interceptors ...Interceptor) {
+	_va := make([]interface{}, len(interceptors))
+	for _i := range interceptors {
+		_va[_i] = interceptors[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _va...)
+	_m.Called(_ca...)
+}
+
+// AlterConnectorOffsets provides a mock function with given fields: req
+func (_m *MockBaseClient) AlterConnectorOffsets(req AlterConnectorOffsetsRequest) (ConnectorOffsetsMessageResponse, error) {
+	ret := _m.Called(req)
+
+	var r0 ConnectorOffsetsMessageResponse
+	if rf, ok := ret.Get(0).(func(AlterConnectorOffsetsRequest) ConnectorOffsetsMessageResponse); ok {
+		r0 = rf(req)
+	} else {
+		r0 = ret.Get(0).(ConnectorOffsetsMessageResponse)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(AlterConnectorOffsetsRequest) error); ok {
+		r1 = rf(req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AlterConnectorOffsetsContext provides a mock function with given fields: ctx, req
+func (_m *MockBaseClient) AlterConnectorOffsetsContext(ctx context.Context, req AlterConnectorOffsetsRequest) (ConnectorOffsetsMessageResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 ConnectorOffsetsMessageResponse
+	if rf, ok := ret.Get(0).(func(context.Context, AlterConnectorOffsetsRequest) ConnectorOffsetsMessageResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Get(0).(ConnectorOffsetsMessageResponse)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, AlterConnectorOffsetsRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CreateConnector provides a mock function with given fields: req
 func (_m *MockBaseClient) CreateConnector(req CreateConnectorRequest) (ConnectorResponse, error) {
 	ret := _m.Called(req)
@@ -33,20 +87,566 @@ func (_m *MockBaseClient) CreateConnector(req CreateConnectorRequest) (Connector
 	return r0, r1
 }
 
-// DeleteConnector provides a mock function with given fields: req
-func (_m *MockBaseClient) DeleteConnector(req ConnectorRequest) (EmptyResponse, error) {
-	ret := _m.Called(req)
+// CreateConnectorContext provides a mock function with given fields: ctx, req
+func (_m *MockBaseClient) CreateConnectorContext(ctx context.Context, req CreateConnectorRequest) (ConnectorResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 ConnectorResponse
+	if rf, ok := ret.Get(0).(func(context.Context, CreateConnectorRequest) ConnectorResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Get(0).(ConnectorResponse)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, CreateConnectorRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteConnector provides a mock function with given fields: req
+func (_m *MockBaseClient) DeleteConnector(req ConnectorRequest) (EmptyResponse, error) {
+	ret := _m.Called(req)
+
+	var r0 EmptyResponse
+	if rf, ok := ret.Get(0).(func(ConnectorRequest) EmptyResponse); ok {
+		r0 = rf(req)
+	} else {
+		r0 = ret.Get(0).(EmptyResponse)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(ConnectorRequest) error); ok {
+		r1 = rf(req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteConnectorContext provides a mock function with given fields: ctx, req
+func (_m *MockBaseClient) DeleteConnectorContext(ctx context.Context, req ConnectorRequest) (EmptyResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 EmptyResponse
+	if rf, ok := ret.Get(0).(func(context.Context, ConnectorRequest) EmptyResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Get(0).(EmptyResponse)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, ConnectorRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetAll provides a mock function with given fields:
+func (_m *MockBaseClient) GetAll() (GetAllConnectorsResponse, error) {
+	ret := _m.Called()
+
+	var r0 GetAllConnectorsResponse
+	if rf, ok := ret.Get(0).(func() GetAllConnectorsResponse); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(GetAllConnectorsResponse)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetAllContext provides a mock function with given fields: ctx
+func (_m *MockBaseClient) GetAllContext(ctx context.Context) (GetAllConnectorsResponse, error) {
+	ret := _m.Called(ctx)
+
+	var r0 GetAllConnectorsResponse
+	if rf, ok := ret.Get(0).(func(context.Context) GetAllConnectorsResponse); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(GetAllConnectorsResponse)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetAllExpanded provides a mock function with given fields: expandStatus, expandInfo
+func (_m *MockBaseClient) GetAllExpanded(expandStatus bool, expandInfo bool) (GetAllExpandedConnectorsResponse, error) {
+	ret := _m.Called(expandStatus, expandInfo)
+
+	var r0 GetAllExpandedConnectorsResponse
+	if rf, ok := ret.Get(0).(func(bool, bool) GetAllExpandedConnectorsResponse); ok {
+		r0 = rf(expandStatus, expandInfo)
+	} else {
+		r0 = ret.Get(0).(GetAllExpandedConnectorsResponse)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(bool, bool) error); ok {
+		r1 = rf(expandStatus, expandInfo)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetAllExpandedContext provides a mock function with given fields: ctx, expandStatus, expandInfo
+func (_m *MockBaseClient) GetAllExpandedContext(ctx context.Context, expandStatus bool, expandInfo bool) (GetAllExpandedConnectorsResponse, error) {
+	ret := _m.Called(ctx, expandStatus, expandInfo)
+
+	var r0 GetAllExpandedConnectorsResponse
+	if rf, ok := ret.Get(0).(func(context.Context, bool, bool) GetAllExpandedConnectorsResponse); ok {
+		r0 = rf(ctx, expandStatus, expandInfo)
+	} else {
+		r0 = ret.Get(0).(GetAllExpandedConnectorsResponse)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, bool, bool) error); ok {
+		r1 = rf(ctx, expandStatus, expandInfo)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetAllTasks provides a mock function with given fields: req
+func (_m *MockBaseClient) GetAllTasks(req ConnectorRequest) (GetAllTasksResponse, error) {
+	ret := _m.Called(req)
+
+	var r0 GetAllTasksResponse
+	if rf, ok := ret.Get(0).(func(ConnectorRequest) GetAllTasksResponse); ok {
+		r0 = rf(req)
+	} else {
+		r0 = ret.Get(0).(GetAllTasksResponse)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(ConnectorRequest) error); ok {
+		r1 = rf(req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetAllTasksContext provides a mock function with given fields: ctx, req
+func (_m *MockBaseClient) GetAllTasksContext(ctx context.Context, req ConnectorRequest) (GetAllTasksResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 GetAllTasksResponse
+	if rf, ok := ret.Get(0).(func(context.Context, ConnectorRequest) GetAllTasksResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Get(0).(GetAllTasksResponse)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, ConnectorRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetConnector provides a mock function with given fields: req
+func (_m *MockBaseClient) GetConnector(req ConnectorRequest) (ConnectorResponse, error) {
+	ret := _m.Called(req)
+
+	var r0 ConnectorResponse
+	if rf, ok := ret.Get(0).(func(ConnectorRequest) ConnectorResponse); ok {
+		r0 = rf(req)
+	} else {
+		r0 = ret.Get(0).(ConnectorResponse)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(ConnectorRequest) error); ok {
+		r1 = rf(req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetConnectorConfig provides a mock function with given fields: req
+func (_m *MockBaseClient) GetConnectorConfig(req ConnectorRequest) (GetConnectorConfigResponse, error) {
+	ret := _m.Called(req)
+
+	var r0 GetConnectorConfigResponse
+	if rf, ok := ret.Get(0).(func(ConnectorRequest) GetConnectorConfigResponse); ok {
+		r0 = rf(req)
+	} else {
+		r0 = ret.Get(0).(GetConnectorConfigResponse)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(ConnectorRequest) error); ok {
+		r1 = rf(req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetConnectorConfigContext provides a mock function with given fields: ctx, req
+func (_m *MockBaseClient) GetConnectorConfigContext(ctx context.Context, req ConnectorRequest) (GetConnectorConfigResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 GetConnectorConfigResponse
+	if rf, ok := ret.Get(0).(func(context.Context, ConnectorRequest) GetConnectorConfigResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Get(0).(GetConnectorConfigResponse)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, ConnectorRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetConnectorContext provides a mock function with given fields: ctx, req
+func (_m *MockBaseClient) GetConnectorContext(ctx context.Context, req ConnectorRequest) (ConnectorResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 ConnectorResponse
+	if rf, ok := ret.Get(0).(func(context.Context, ConnectorRequest) ConnectorResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Get(0).(ConnectorResponse)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, ConnectorRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetConnectorOffsets provides a mock function with given fields: req
+func (_m *MockBaseClient) GetConnectorOffsets(req ConnectorRequest) (GetConnectorOffsetsResponse, error) {
+	ret := _m.Called(req)
+
+	var r0 GetConnectorOffsetsResponse
+	if rf, ok := ret.Get(0).(func(ConnectorRequest) GetConnectorOffsetsResponse); ok {
+		r0 = rf(req)
+	} else {
+		r0 = ret.Get(0).(GetConnectorOffsetsResponse)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(ConnectorRequest) error); ok {
+		r1 = rf(req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetConnectorOffsetsContext provides a mock function with given fields: ctx, req
+func (_m *MockBaseClient) GetConnectorOffsetsContext(ctx context.Context, req ConnectorRequest) (GetConnectorOffsetsResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 GetConnectorOffsetsResponse
+	if rf, ok := ret.Get(0).(func(context.Context, ConnectorRequest) GetConnectorOffsetsResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Get(0).(GetConnectorOffsetsResponse)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, ConnectorRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetConnectorPlugins provides a mock function with given fields:
+func (_m *MockBaseClient) GetConnectorPlugins() (GetConnectorPluginsResponse, error) {
+	ret := _m.Called()
+
+	var r0 GetConnectorPluginsResponse
+	if rf, ok := ret.Get(0).(func() GetConnectorPluginsResponse); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(GetConnectorPluginsResponse)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetConnectorPluginsContext provides a mock function with given fields: ctx
+func (_m *MockBaseClient) GetConnectorPluginsContext(ctx context.Context) (GetConnectorPluginsResponse, error) {
+	ret := _m.Called(ctx)
+
+	var r0 GetConnectorPluginsResponse
+	if rf, ok := ret.Get(0).(func(context.Context) GetConnectorPluginsResponse); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(GetConnectorPluginsResponse)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetConnectorStatus provides a mock function with given fields: req
+func (_m *MockBaseClient) GetConnectorStatus(req ConnectorRequest) (GetConnectorStatusResponse, error) {
+	ret := _m.Called(req)
+
+	var r0 GetConnectorStatusResponse
+	if rf, ok := ret.Get(0).(func(ConnectorRequest) GetConnectorStatusResponse); ok {
+		r0 = rf(req)
+	} else {
+		r0 = ret.Get(0).(GetConnectorStatusResponse)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(ConnectorRequest) error); ok {
+		r1 = rf(req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetConnectorStatusContext provides a mock function with given fields: ctx, req
+func (_m *MockBaseClient) GetConnectorStatusContext(ctx context.Context, req ConnectorRequest) (GetConnectorStatusResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 GetConnectorStatusResponse
+	if rf, ok := ret.Get(0).(func(context.Context, ConnectorRequest) GetConnectorStatusResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Get(0).(GetConnectorStatusResponse)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, ConnectorRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetConnectorTopics provides a mock function with given fields: req
+func (_m *MockBaseClient) GetConnectorTopics(req ConnectorRequest) (GetConnectorTopicsResponse, error) {
+	ret := _m.Called(req)
+
+	var r0 GetConnectorTopicsResponse
+	if rf, ok := ret.Get(0).(func(ConnectorRequest) GetConnectorTopicsResponse); ok {
+		r0 = rf(req)
+	} else {
+		r0 = ret.Get(0).(GetConnectorTopicsResponse)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(ConnectorRequest) error); ok {
+		r1 = rf(req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetConnectorTopicsContext provides a mock function with given fields: ctx, req
+func (_m *MockBaseClient) GetConnectorTopicsContext(ctx context.Context, req ConnectorRequest) (GetConnectorTopicsResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 GetConnectorTopicsResponse
+	if rf, ok := ret.Get(0).(func(context.Context, ConnectorRequest) GetConnectorTopicsResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Get(0).(GetConnectorTopicsResponse)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, ConnectorRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetLogger provides a mock function with given fields: req
+func (_m *MockBaseClient) GetLogger(req LoggerRequest) (GetLoggerResponse, error) {
+	ret := _m.Called(req)
+
+	var r0 GetLoggerResponse
+	if rf, ok := ret.Get(0).(func(LoggerRequest) GetLoggerResponse); ok {
+		r0 = rf(req)
+	} else {
+		r0 = ret.Get(0).(GetLoggerResponse)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(LoggerRequest) error); ok {
+		r1 = rf(req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetLoggerContext provides a mock function with given fields: ctx, req
+func (_m *MockBaseClient) GetLoggerContext(ctx context.Context, req LoggerRequest) (GetLoggerResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 GetLoggerResponse
+	if rf, ok := ret.Get(0).(func(context.Context, LoggerRequest) GetLoggerResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Get(0).(GetLoggerResponse)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, LoggerRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetLoggers provides a mock function with given fields:
+func (_m *MockBaseClient) GetLoggers() (GetLoggersResponse, error) {
+	ret := _m.Called()
+
+	var r0 GetLoggersResponse
+	if rf, ok := ret.Get(0).(func() GetLoggersResponse); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(GetLoggersResponse)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetLoggersContext provides a mock function with given fields: ctx
+func (_m *MockBaseClient) GetLoggersContext(ctx context.Context) (GetLoggersResponse, error) {
+	ret := _m.Called(ctx)
+
+	var r0 GetLoggersResponse
+	if rf, ok := ret.Get(0).(func(context.Context) GetLoggersResponse); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(GetLoggersResponse)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTaskStatus provides a mock function with given fields: req
+func (_m *MockBaseClient) GetTaskStatus(req TaskRequest) (TaskStatusResponse, error) {
+	ret := _m.Called(req)
+
+	var r0 TaskStatusResponse
+	if rf, ok := ret.Get(0).(func(TaskRequest) TaskStatusResponse); ok {
+		r0 = rf(req)
+	} else {
+		r0 = ret.Get(0).(TaskStatusResponse)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(TaskRequest) error); ok {
+		r1 = rf(req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTaskStatusContext provides a mock function with given fields: ctx, req
+func (_m *MockBaseClient) GetTaskStatusContext(ctx context.Context, req TaskRequest) (TaskStatusResponse, error) {
+	ret := _m.Called(ctx, req)
 
-	var r0 EmptyResponse
-	if rf, ok := ret.Get(0).(func(ConnectorRequest) EmptyResponse); ok {
-		r0 = rf(req)
+	var r0 TaskStatusResponse
+	if rf, ok := ret.Get(0).(func(context.Context, TaskRequest) TaskStatusResponse); ok {
+		r0 = rf(ctx, req)
 	} else {
-		r0 = ret.Get(0).(EmptyResponse)
+		r0 = ret.Get(0).(TaskStatusResponse)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(ConnectorRequest) error); ok {
-		r1 = rf(req)
+	if rf, ok := ret.Get(1).(func(context.Context, TaskRequest) error); ok {
+		r1 = rf(ctx, req)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -54,15 +654,15 @@ func (_m *MockBaseClient) DeleteConnector(req ConnectorRequest) (EmptyResponse,
 	return r0, r1
 }
 
-// GetAll provides a mock function with given fields:
-func (_m *MockBaseClient) GetAll() (GetAllConnectorsResponse, error) {
+// GetWorkerInfo provides a mock function with given fields:
+func (_m *MockBaseClient) GetWorkerInfo() (WorkerInfoResponse, error) {
 	ret := _m.Called()
 
-	var r0 GetAllConnectorsResponse
-	if rf, ok := ret.Get(0).(func() GetAllConnectorsResponse); ok {
+	var r0 WorkerInfoResponse
+	if rf, ok := ret.Get(0).(func() WorkerInfoResponse); ok {
 		r0 = rf()
 	} else {
-		r0 = ret.Get(0).(GetAllConnectorsResponse)
+		r0 = ret.Get(0).(WorkerInfoResponse)
 	}
 
 	var r1 error
@@ -75,20 +675,20 @@ func (_m *MockBaseClient) GetAll() (GetAllConnectorsResponse, error) {
 	return r0, r1
 }
 
-// GetAllTasks provides a mock function with given fields: req
-func (_m *MockBaseClient) GetAllTasks(req ConnectorRequest) (GetAllTasksResponse, error) {
-	ret := _m.Called(req)
+// GetWorkerInfoContext provides a mock function with given fields: ctx
+func (_m *MockBaseClient) GetWorkerInfoContext(ctx context.Context) (WorkerInfoResponse, error) {
+	ret := _m.Called(ctx)
 
-	var r0 GetAllTasksResponse
-	if rf, ok := ret.Get(0).(func(ConnectorRequest) GetAllTasksResponse); ok {
-		r0 = rf(req)
+	var r0 WorkerInfoResponse
+	if rf, ok := ret.Get(0).(func(context.Context) WorkerInfoResponse); ok {
+		r0 = rf(ctx)
 	} else {
-		r0 = ret.Get(0).(GetAllTasksResponse)
+		r0 = ret.Get(0).(WorkerInfoResponse)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(ConnectorRequest) error); ok {
-		r1 = rf(req)
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -96,15 +696,15 @@ func (_m *MockBaseClient) GetAllTasks(req ConnectorRequest) (GetAllTasksResponse
 	return r0, r1
 }
 
-// GetConnector provides a mock function with given fields: req
-func (_m *MockBaseClient) GetConnector(req ConnectorRequest) (ConnectorResponse, error) {
+// PauseConnector provides a mock function with given fields: req
+func (_m *MockBaseClient) PauseConnector(req ConnectorRequest) (EmptyResponse, error) {
 	ret := _m.Called(req)
 
-	var r0 ConnectorResponse
-	if rf, ok := ret.Get(0).(func(ConnectorRequest) ConnectorResponse); ok {
+	var r0 EmptyResponse
+	if rf, ok := ret.Get(0).(func(ConnectorRequest) EmptyResponse); ok {
 		r0 = rf(req)
 	} else {
-		r0 = ret.Get(0).(ConnectorResponse)
+		r0 = ret.Get(0).(EmptyResponse)
 	}
 
 	var r1 error
@@ -117,20 +717,20 @@ func (_m *MockBaseClient) GetConnector(req ConnectorRequest) (ConnectorResponse,
 	return r0, r1
 }
 
-// GetConnectorConfig provides a mock function with given fields: req
-func (_m *MockBaseClient) GetConnectorConfig(req ConnectorRequest) (GetConnectorConfigResponse, error) {
-	ret := _m.Called(req)
+// PauseConnectorContext provides a mock function with given fields: ctx, req
+func (_m *MockBaseClient) PauseConnectorContext(ctx context.Context, req ConnectorRequest) (EmptyResponse, error) {
+	ret := _m.Called(ctx, req)
 
-	var r0 GetConnectorConfigResponse
-	if rf, ok := ret.Get(0).(func(ConnectorRequest) GetConnectorConfigResponse); ok {
-		r0 = rf(req)
+	var r0 EmptyResponse
+	if rf, ok := ret.Get(0).(func(context.Context, ConnectorRequest) EmptyResponse); ok {
+		r0 = rf(ctx, req)
 	} else {
-		r0 = ret.Get(0).(GetConnectorConfigResponse)
+		r0 = ret.Get(0).(EmptyResponse)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(ConnectorRequest) error); ok {
-		r1 = rf(req)
+	if rf, ok := ret.Get(1).(func(context.Context, ConnectorRequest) error); ok {
+		r1 = rf(ctx, req)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -138,15 +738,15 @@ func (_m *MockBaseClient) GetConnectorConfig(req ConnectorRequest) (GetConnector
 	return r0, r1
 }
 
-// GetConnectorStatus provides a mock function with given fields: req
-func (_m *MockBaseClient) GetConnectorStatus(req ConnectorRequest) (GetConnectorStatusResponse, error) {
+// ResetConnectorOffsets provides a mock function with given fields: req
+func (_m *MockBaseClient) ResetConnectorOffsets(req ConnectorRequest) (ConnectorOffsetsMessageResponse, error) {
 	ret := _m.Called(req)
 
-	var r0 GetConnectorStatusResponse
-	if rf, ok := ret.Get(0).(func(ConnectorRequest) GetConnectorStatusResponse); ok {
+	var r0 ConnectorOffsetsMessageResponse
+	if rf, ok := ret.Get(0).(func(ConnectorRequest) ConnectorOffsetsMessageResponse); ok {
 		r0 = rf(req)
 	} else {
-		r0 = ret.Get(0).(GetConnectorStatusResponse)
+		r0 = ret.Get(0).(ConnectorOffsetsMessageResponse)
 	}
 
 	var r1 error
@@ -159,20 +759,20 @@ func (_m *MockBaseClient) GetConnectorStatus(req ConnectorRequest) (GetConnector
 	return r0, r1
 }
 
-// GetTaskStatus provides a mock function with given fields: req
-func (_m *MockBaseClient) GetTaskStatus(req TaskRequest) (TaskStatusResponse, error) {
-	ret := _m.Called(req)
+// ResetConnectorOffsetsContext provides a mock function with given fields: ctx, req
+func (_m *MockBaseClient) ResetConnectorOffsetsContext(ctx context.Context, req ConnectorRequest) (ConnectorOffsetsMessageResponse, error) {
+	ret := _m.Called(ctx, req)
 
-	var r0 TaskStatusResponse
-	if rf, ok := ret.Get(0).(func(TaskRequest) TaskStatusResponse); ok {
-		r0 = rf(req)
+	var r0 ConnectorOffsetsMessageResponse
+	if rf, ok := ret.Get(0).(func(context.Context, ConnectorRequest) ConnectorOffsetsMessageResponse); ok {
+		r0 = rf(ctx, req)
 	} else {
-		r0 = ret.Get(0).(TaskStatusResponse)
+		r0 = ret.Get(0).(ConnectorOffsetsMessageResponse)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(TaskRequest) error); ok {
-		r1 = rf(req)
+	if rf, ok := ret.Get(1).(func(context.Context, ConnectorRequest) error); ok {
+		r1 = rf(ctx, req)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -180,8 +780,8 @@ func (_m *MockBaseClient) GetTaskStatus(req TaskRequest) (TaskStatusResponse, er
 	return r0, r1
 }
 
-// PauseConnector provides a mock function with given fields: req
-func (_m *MockBaseClient) PauseConnector(req ConnectorRequest) (EmptyResponse, error) {
+// ResetConnectorTopics provides a mock function with given fields: req
+func (_m *MockBaseClient) ResetConnectorTopics(req ConnectorRequest) (EmptyResponse, error) {
 	ret := _m.Called(req)
 
 	var r0 EmptyResponse
@@ -201,6 +801,27 @@ func (_m *MockBaseClient) PauseConnector(req ConnectorRequest) (EmptyResponse, e
 	return r0, r1
 }
 
+// ResetConnectorTopicsContext provides a mock function with given fields: ctx, req
+func (_m *MockBaseClient) ResetConnectorTopicsContext(ctx context.Context, req ConnectorRequest) (EmptyResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 EmptyResponse
+	if rf, ok := ret.Get(0).(func(context.Context, ConnectorRequest) EmptyResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Get(0).(EmptyResponse)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, ConnectorRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // RestartConnector provides a mock function with given fields: req
 func (_m *MockBaseClient) RestartConnector(req ConnectorRequest) (EmptyResponse, error) {
 	ret := _m.Called(req)
@@ -222,6 +843,69 @@ func (_m *MockBaseClient) RestartConnector(req ConnectorRequest) (EmptyResponse,
 	return r0, r1
 }
 
+// RestartConnectorContext provides a mock function with given fields: ctx, req
+func (_m *MockBaseClient) RestartConnectorContext(ctx context.Context, req ConnectorRequest) (EmptyResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 EmptyResponse
+	if rf, ok := ret.Get(0).(func(context.Context, ConnectorRequest) EmptyResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Get(0).(EmptyResponse)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, ConnectorRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RestartConnectorWithOptions provides a mock function with given fields: req
+func (_m *MockBaseClient) RestartConnectorWithOptions(req RestartConnectorRequest) (RestartConnectorResponse, error) {
+	ret := _m.Called(req)
+
+	var r0 RestartConnectorResponse
+	if rf, ok := ret.Get(0).(func(RestartConnectorRequest) RestartConnectorResponse); ok {
+		r0 = rf(req)
+	} else {
+		r0 = ret.Get(0).(RestartConnectorResponse)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(RestartConnectorRequest) error); ok {
+		r1 = rf(req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RestartConnectorWithOptionsContext provides a mock function with given fields: ctx, req
+func (_m *MockBaseClient) RestartConnectorWithOptionsContext(ctx context.Context, req RestartConnectorRequest) (RestartConnectorResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 RestartConnectorResponse
+	if rf, ok := ret.Get(0).(func(context.Context, RestartConnectorRequest) RestartConnectorResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Get(0).(RestartConnectorResponse)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, RestartConnectorRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // RestartTask provides a mock function with given fields: req
 func (_m *MockBaseClient) RestartTask(req TaskRequest) (EmptyResponse, error) {
 	ret := _m.Called(req)
@@ -243,6 +927,27 @@ func (_m *MockBaseClient) RestartTask(req TaskRequest) (EmptyResponse, error) {
 	return r0, r1
 }
 
+// RestartTaskContext provides a mock function with given fields: ctx, req
+func (_m *MockBaseClient) RestartTaskContext(ctx context.Context, req TaskRequest) (EmptyResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 EmptyResponse
+	if rf, ok := ret.Get(0).(func(context.Context, TaskRequest) EmptyResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Get(0).(EmptyResponse)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, TaskRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ResumeConnector provides a mock function with given fields: req
 func (_m *MockBaseClient) ResumeConnector(req ConnectorRequest) (EmptyResponse, error) {
 	ret := _m.Called(req)
@@ -264,6 +969,27 @@ func (_m *MockBaseClient) ResumeConnector(req ConnectorRequest) (EmptyResponse,
 	return r0, r1
 }
 
+// ResumeConnectorContext provides a mock function with given fields: ctx, req
+func (_m *MockBaseClient) ResumeConnectorContext(ctx context.Context, req ConnectorRequest) (EmptyResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 EmptyResponse
+	if rf, ok := ret.Get(0).(func(context.Context, ConnectorRequest) EmptyResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Get(0).(EmptyResponse)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, ConnectorRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // SetBasicAuth provides a mock function with given fields: username, password
 func (_m *MockBaseClient) SetBasicAuth(username string, password string) {
 	_m.Called(username, password)
@@ -294,6 +1020,115 @@ func (_m *MockBaseClient) SetInsecureSSL() {
 	_m.Called()
 }
 
+// SetLogLevel provides a mock function with given fields: req
+func (_m *MockBaseClient) SetLogLevel(req SetLogLevelRequest) (SetLogLevelResponse, error) {
+	ret := _m.Called(req)
+
+	var r0 SetLogLevelResponse
+	if rf, ok := ret.Get(0).(func(SetLogLevelRequest) SetLogLevelResponse); ok {
+		r0 = rf(req)
+	} else {
+		r0 = ret.Get(0).(SetLogLevelResponse)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(SetLogLevelRequest) error); ok {
+		r1 = rf(req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SetLogLevelContext provides a mock function with given fields: ctx, req
+func (_m *MockBaseClient) SetLogLevelContext(ctx context.Context, req SetLogLevelRequest) (SetLogLevelResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 SetLogLevelResponse
+	if rf, ok := ret.Get(0).(func(context.Context, SetLogLevelRequest) SetLogLevelResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Get(0).(SetLogLevelResponse)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, SetLogLevelRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SetLogger provides a mock function with given fields: logger
+func (_m *MockBaseClient) SetLogger(logger Logger) {
+	_m.Called(logger)
+}
+
+// SetMaxInFlight provides a mock function with given fields: max
+func (_m *MockBaseClient) SetMaxInFlight(max int) {
+	_m.Called(max)
+}
+
+// SetNotFoundAsError provides a mock function with given fields: enabled
+func (_m *MockBaseClient) SetNotFoundAsError(enabled bool) {
+	_m.Called(enabled)
+}
+
+// SetRateLimit provides a mock function with given fields: requestsPerSecond, burst
+func (_m *MockBaseClient) SetRateLimit(requestsPerSecond float64, burst int) {
+	_m.Called(requestsPerSecond, burst)
+}
+
+// SetTokenSource provides a mock function with given fields: source
+func (_m *MockBaseClient) SetTokenSource(source TokenSource) {
+	_m.Called(source)
+}
+
+// StopConnector provides a mock function with given fields: req
+func (_m *MockBaseClient) StopConnector(req ConnectorRequest) (EmptyResponse, error) {
+	ret := _m.Called(req)
+
+	var r0 EmptyResponse
+	if rf, ok := ret.Get(0).(func(ConnectorRequest) EmptyResponse); ok {
+		r0 = rf(req)
+	} else {
+		r0 = ret.Get(0).(EmptyResponse)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(ConnectorRequest) error); ok {
+		r1 = rf(req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StopConnectorContext provides a mock function with given fields: ctx, req
+func (_m *MockBaseClient) StopConnectorContext(ctx context.Context, req ConnectorRequest) (EmptyResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 EmptyResponse
+	if rf, ok := ret.Get(0).(func(context.Context, ConnectorRequest) EmptyResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Get(0).(EmptyResponse)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, ConnectorRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // UpdateConnector provides a mock function with given fields: req
 func (_m *MockBaseClient) UpdateConnector(req CreateConnectorRequest) (ConnectorResponse, error) {
 	ret := _m.Called(req)
@@ -314,3 +1149,66 @@ func (_m *MockBaseClient) UpdateConnector(req CreateConnectorRequest) (Connector
 
 	return r0, r1
 }
+
+// UpdateConnectorContext provides a mock function with given fields: ctx, req
+func (_m *MockBaseClient) UpdateConnectorContext(ctx context.Context, req CreateConnectorRequest) (ConnectorResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 ConnectorResponse
+	if rf, ok := ret.Get(0).(func(context.Context, CreateConnectorRequest) ConnectorResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Get(0).(ConnectorResponse)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, CreateConnectorRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ValidateConnectorConfig provides a mock function with given fields: req
+func (_m *MockBaseClient) ValidateConnectorConfig(req ValidateConnectorConfigRequest) (ValidateConnectorConfigResponse, error) {
+	ret := _m.Called(req)
+
+	var r0 ValidateConnectorConfigResponse
+	if rf, ok := ret.Get(0).(func(ValidateConnectorConfigRequest) ValidateConnectorConfigResponse); ok {
+		r0 = rf(req)
+	} else {
+		r0 = ret.Get(0).(ValidateConnectorConfigResponse)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(ValidateConnectorConfigRequest) error); ok {
+		r1 = rf(req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ValidateConnectorConfigContext provides a mock function with given fields: ctx, req
+func (_m *MockBaseClient) ValidateConnectorConfigContext(ctx context.Context, req ValidateConnectorConfigRequest) (ValidateConnectorConfigResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 ValidateConnectorConfigResponse
+	if rf, ok := ret.Get(0).(func(context.Context, ValidateConnectorConfigRequest) ValidateConnectorConfigResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Get(0).(ValidateConnectorConfigResponse)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, ValidateConnectorConfigRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
@@ -4,14 +4,115 @@
 
 package connectors
 
+import context "context"
 import mock "github.com/stretchr/testify/mock"
 import tls "crypto/tls"
+import time "time"
 
 // MockHighLevelClient is an autogenerated mock type for the HighLevelClient type
 type MockHighLevelClient struct {
 	mock.Mock
 }
 
+// AddInterceptor provides a mock function with given fields: interceptors
+func (_m *MockHighLevelClient) AddInterceptor(interceptors ...Interceptor) {
+	_va := make([]interface{}, len(interceptors))
+	for _i := range interceptors {
+		_va[_i] = interceptors[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _va...)
+	_m.Called(_ca...)
+}
+
+// AlterConnectorOffsets provides a mock function with given fields: req, sync
+func (_m *MockHighLevelClient) AlterConnectorOffsets(req AlterConnectorOffsetsRequest, sync bool) (ConnectorOffsetsMessageResponse, error) {
+	ret := _m.Called(req, sync)
+
+	var r0 ConnectorOffsetsMessageResponse
+	if rf, ok := ret.Get(0).(func(AlterConnectorOffsetsRequest, bool) ConnectorOffsetsMessageResponse); ok {
+		r0 = rf(req, sync)
+	} else {
+		r0 = ret.Get(0).(ConnectorOffsetsMessageResponse)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(AlterConnectorOffsetsRequest, bool) error); ok {
+		r1 = rf(req, sync)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AlterConnectorOffsetsContext provides a mock function with given fields: ctx, req, sync
+func (_m *MockHighLevelClient) AlterConnectorOffsetsContext(ctx context.Context, req AlterConnectorOffsetsRequest, sync bool) (ConnectorOffsetsMessageResponse, error) {
+	ret := _m.Called(ctx, req, sync)
+
+	var r0 ConnectorOffsetsMessageResponse
+	if rf, ok := ret.Get(0).(func(context.Context, AlterConnectorOffsetsRequest, bool) ConnectorOffsetsMessageResponse); ok {
+		r0 = rf(ctx, req, sync)
+	} else {
+		r0 = ret.Get(0).(ConnectorOffsetsMessageResponse)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, AlterConnectorOffsetsRequest, bool) error); ok {
+		r1 = rf(ctx, req, sync)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Apply provides a mock function with given fields: plan
+func (_m *MockHighLevelClient) Apply(plan Plan) ([]DeployResult, error) {
+	ret := _m.Called(plan)
+
+	var r0 []DeployResult
+	if rf, ok := ret.Get(0).(func(Plan) []DeployResult); ok {
+		r0 = rf(plan)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]DeployResult)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(Plan) error); ok {
+		r1 = rf(plan)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ApplyContext provides a mock function with given fields: ctx, plan
+func (_m *MockHighLevelClient) ApplyContext(ctx context.Context, plan Plan) ([]DeployResult, error) {
+	ret := _m.Called(ctx, plan)
+
+	var r0 []DeployResult
+	if rf, ok := ret.Get(0).(func(context.Context, Plan) []DeployResult); ok {
+		r0 = rf(ctx, plan)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]DeployResult)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, Plan) error); ok {
+		r1 = rf(ctx, plan)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CreateConnector provides a mock function with given fields: req, sync
 func (_m *MockHighLevelClient) CreateConnector(req CreateConnectorRequest, sync bool) (ConnectorResponse, error) {
 	ret := _m.Called(req, sync)
@@ -33,6 +134,27 @@ func (_m *MockHighLevelClient) CreateConnector(req CreateConnectorRequest, sync
 	return r0, r1
 }
 
+// CreateConnectorContext provides a mock function with given fields: ctx, req, sync
+func (_m *MockHighLevelClient) CreateConnectorContext(ctx context.Context, req CreateConnectorRequest, sync bool) (ConnectorResponse, error) {
+	ret := _m.Called(ctx, req, sync)
+
+	var r0 ConnectorResponse
+	if rf, ok := ret.Get(0).(func(context.Context, CreateConnectorRequest, bool) ConnectorResponse); ok {
+		r0 = rf(ctx, req, sync)
+	} else {
+		r0 = ret.Get(0).(ConnectorResponse)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, CreateConnectorRequest, bool) error); ok {
+		r1 = rf(ctx, req, sync)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // DeleteConnector provides a mock function with given fields: req, sync
 func (_m *MockHighLevelClient) DeleteConnector(req ConnectorRequest, sync bool) (EmptyResponse, error) {
 	ret := _m.Called(req, sync)
@@ -54,6 +176,27 @@ func (_m *MockHighLevelClient) DeleteConnector(req ConnectorRequest, sync bool)
 	return r0, r1
 }
 
+// DeleteConnectorContext provides a mock function with given fields: ctx, req, sync
+func (_m *MockHighLevelClient) DeleteConnectorContext(ctx context.Context, req ConnectorRequest, sync bool) (EmptyResponse, error) {
+	ret := _m.Called(ctx, req, sync)
+
+	var r0 EmptyResponse
+	if rf, ok := ret.Get(0).(func(context.Context, ConnectorRequest, bool) EmptyResponse); ok {
+		r0 = rf(ctx, req, sync)
+	} else {
+		r0 = ret.Get(0).(EmptyResponse)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, ConnectorRequest, bool) error); ok {
+		r1 = rf(ctx, req, sync)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // DeployConnector provides a mock function with given fields: req
 func (_m *MockHighLevelClient) DeployConnector(req CreateConnectorRequest) error {
 	ret := _m.Called(req)
@@ -68,6 +211,62 @@ func (_m *MockHighLevelClient) DeployConnector(req CreateConnectorRequest) error
 	return r0
 }
 
+// DeployConnectorContext provides a mock function with given fields: ctx, req
+func (_m *MockHighLevelClient) DeployConnectorContext(ctx context.Context, req CreateConnectorRequest) error {
+	ret := _m.Called(ctx, req)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, CreateConnectorRequest) error); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeployConnectorWithResult provides a mock function with given fields: req
+func (_m *MockHighLevelClient) DeployConnectorWithResult(req CreateConnectorRequest) (DeployResult, error) {
+	ret := _m.Called(req)
+
+	var r0 DeployResult
+	if rf, ok := ret.Get(0).(func(CreateConnectorRequest) DeployResult); ok {
+		r0 = rf(req)
+	} else {
+		r0 = ret.Get(0).(DeployResult)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(CreateConnectorRequest) error); ok {
+		r1 = rf(req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeployConnectorWithResultContext provides a mock function with given fields: ctx, req
+func (_m *MockHighLevelClient) DeployConnectorWithResultContext(ctx context.Context, req CreateConnectorRequest) (DeployResult, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 DeployResult
+	if rf, ok := ret.Get(0).(func(context.Context, CreateConnectorRequest) DeployResult); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Get(0).(DeployResult)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, CreateConnectorRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // DeployMultipleConnector provides a mock function with given fields: connectors
 func (_m *MockHighLevelClient) DeployMultipleConnector(connectors []CreateConnectorRequest) error {
 	ret := _m.Called(connectors)
@@ -82,6 +281,66 @@ func (_m *MockHighLevelClient) DeployMultipleConnector(connectors []CreateConnec
 	return r0
 }
 
+// DeployMultipleConnectorContext provides a mock function with given fields: ctx, connectors
+func (_m *MockHighLevelClient) DeployMultipleConnectorContext(ctx context.Context, connectors []CreateConnectorRequest) error {
+	ret := _m.Called(ctx, connectors)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []CreateConnectorRequest) error); ok {
+		r0 = rf(ctx, connectors)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeployMultipleConnectorWithResult provides a mock function with given fields: connectors
+func (_m *MockHighLevelClient) DeployMultipleConnectorWithResult(connectors []CreateConnectorRequest) ([]DeployResult, error) {
+	ret := _m.Called(connectors)
+
+	var r0 []DeployResult
+	if rf, ok := ret.Get(0).(func([]CreateConnectorRequest) []DeployResult); ok {
+		r0 = rf(connectors)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]DeployResult)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func([]CreateConnectorRequest) error); ok {
+		r1 = rf(connectors)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeployMultipleConnectorWithResultContext provides a mock function with given fields: ctx, connectors
+func (_m *MockHighLevelClient) DeployMultipleConnectorWithResultContext(ctx context.Context, connectors []CreateConnectorRequest) ([]DeployResult, error) {
+	ret := _m.Called(ctx, connectors)
+
+	var r0 []DeployResult
+	if rf, ok := ret.Get(0).(func(context.Context, []CreateConnectorRequest) []DeployResult); ok {
+		r0 = rf(ctx, connectors)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]DeployResult)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, []CreateConnectorRequest) error); ok {
+		r1 = rf(ctx, connectors)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetAll provides a mock function with given fields:
 func (_m *MockHighLevelClient) GetAll() (GetAllConnectorsResponse, error) {
 	ret := _m.Called()
@@ -103,20 +362,20 @@ func (_m *MockHighLevelClient) GetAll() (GetAllConnectorsResponse, error) {
 	return r0, r1
 }
 
-// GetAllTasks provides a mock function with given fields: req
-func (_m *MockHighLevelClient) GetAllTasks(req ConnectorRequest) (GetAllTasksResponse, error) {
-	ret := _m.Called(req)
+// GetAllContext provides a mock function with given fields: ctx
+func (_m *MockHighLevelClient) GetAllContext(ctx context.Context) (GetAllConnectorsResponse, error) {
+	ret := _m.Called(ctx)
 
-	var r0 GetAllTasksResponse
-	if rf, ok := ret.Get(0).(func(ConnectorRequest) GetAllTasksResponse); ok {
-		r0 = rf(req)
+	var r0 GetAllConnectorsResponse
+	if rf, ok := ret.Get(0).(func(context.Context) GetAllConnectorsResponse); ok {
+		r0 = rf(ctx)
 	} else {
-		r0 = ret.Get(0).(GetAllTasksResponse)
+		r0 = ret.Get(0).(GetAllConnectorsResponse)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(ConnectorRequest) error); ok {
-		r1 = rf(req)
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -124,20 +383,20 @@ func (_m *MockHighLevelClient) GetAllTasks(req ConnectorRequest) (GetAllTasksRes
 	return r0, r1
 }
 
-// GetConnector provides a mock function with given fields: req
-func (_m *MockHighLevelClient) GetConnector(req ConnectorRequest) (ConnectorResponse, error) {
-	ret := _m.Called(req)
+// GetAllExpanded provides a mock function with given fields: expandStatus, expandInfo
+func (_m *MockHighLevelClient) GetAllExpanded(expandStatus bool, expandInfo bool) (GetAllExpandedConnectorsResponse, error) {
+	ret := _m.Called(expandStatus, expandInfo)
 
-	var r0 ConnectorResponse
-	if rf, ok := ret.Get(0).(func(ConnectorRequest) ConnectorResponse); ok {
-		r0 = rf(req)
+	var r0 GetAllExpandedConnectorsResponse
+	if rf, ok := ret.Get(0).(func(bool, bool) GetAllExpandedConnectorsResponse); ok {
+		r0 = rf(expandStatus, expandInfo)
 	} else {
-		r0 = ret.Get(0).(ConnectorResponse)
+		r0 = ret.Get(0).(GetAllExpandedConnectorsResponse)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(ConnectorRequest) error); ok {
-		r1 = rf(req)
+	if rf, ok := ret.Get(1).(func(bool, bool) error); ok {
+		r1 = rf(expandStatus, expandInfo)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -145,20 +404,20 @@ func (_m *MockHighLevelClient) GetConnector(req ConnectorRequest) (ConnectorResp
 	return r0, r1
 }
 
-// GetConnectorConfig provides a mock function with given fields: req
-func (_m *MockHighLevelClient) GetConnectorConfig(req ConnectorRequest) (GetConnectorConfigResponse, error) {
-	ret := _m.Called(req)
+// GetAllExpandedContext provides a mock function with given fields: ctx, expandStatus, expandInfo
+func (_m *MockHighLevelClient) GetAllExpandedContext(ctx context.Context, expandStatus bool, expandInfo bool) (GetAllExpandedConnectorsResponse, error) {
+	ret := _m.Called(ctx, expandStatus, expandInfo)
 
-	var r0 GetConnectorConfigResponse
-	if rf, ok := ret.Get(0).(func(ConnectorRequest) GetConnectorConfigResponse); ok {
-		r0 = rf(req)
+	var r0 GetAllExpandedConnectorsResponse
+	if rf, ok := ret.Get(0).(func(context.Context, bool, bool) GetAllExpandedConnectorsResponse); ok {
+		r0 = rf(ctx, expandStatus, expandInfo)
 	} else {
-		r0 = ret.Get(0).(GetConnectorConfigResponse)
+		r0 = ret.Get(0).(GetAllExpandedConnectorsResponse)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(ConnectorRequest) error); ok {
-		r1 = rf(req)
+	if rf, ok := ret.Get(1).(func(context.Context, bool, bool) error); ok {
+		r1 = rf(ctx, expandStatus, expandInfo)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -166,15 +425,15 @@ func (_m *MockHighLevelClient) GetConnectorConfig(req ConnectorRequest) (GetConn
 	return r0, r1
 }
 
-// GetConnectorStatus provides a mock function with given fields: req
-func (_m *MockHighLevelClient) GetConnectorStatus(req ConnectorRequest) (GetConnectorStatusResponse, error) {
+// GetAllTasks provides a mock function with given fields: req
+func (_m *MockHighLevelClient) GetAllTasks(req ConnectorRequest) (GetAllTasksResponse, error) {
 	ret := _m.Called(req)
 
-	var r0 GetConnectorStatusResponse
-	if rf, ok := ret.Get(0).(func(ConnectorRequest) GetConnectorStatusResponse); ok {
+	var r0 GetAllTasksResponse
+	if rf, ok := ret.Get(0).(func(ConnectorRequest) GetAllTasksResponse); ok {
 		r0 = rf(req)
 	} else {
-		r0 = ret.Get(0).(GetConnectorStatusResponse)
+		r0 = ret.Get(0).(GetAllTasksResponse)
 	}
 
 	var r1 error
@@ -187,20 +446,20 @@ func (_m *MockHighLevelClient) GetConnectorStatus(req ConnectorRequest) (GetConn
 	return r0, r1
 }
 
-// GetTaskStatus provides a mock function with given fields: req
-func (_m *MockHighLevelClient) GetTaskStatus(req TaskRequest) (TaskStatusResponse, error) {
-	ret := _m.Called(req)
+// GetAllTasksContext provides a mock function with given fields: ctx, req
+func (_m *MockHighLevelClient) GetAllTasksContext(ctx context.Context, req ConnectorRequest) (GetAllTasksResponse, error) {
+	ret := _m.Called(ctx, req)
 
-	var r0 TaskStatusResponse
-	if rf, ok := ret.Get(0).(func(TaskRequest) TaskStatusResponse); ok {
-		r0 = rf(req)
+	var r0 GetAllTasksResponse
+	if rf, ok := ret.Get(0).(func(context.Context, ConnectorRequest) GetAllTasksResponse); ok {
+		r0 = rf(ctx, req)
 	} else {
-		r0 = ret.Get(0).(TaskStatusResponse)
+		r0 = ret.Get(0).(GetAllTasksResponse)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(TaskRequest) error); ok {
-		r1 = rf(req)
+	if rf, ok := ret.Get(1).(func(context.Context, ConnectorRequest) error); ok {
+		r1 = rf(ctx, req)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -208,20 +467,20 @@ func (_m *MockHighLevelClient) GetTaskStatus(req TaskRequest) (TaskStatusRespons
 	return r0, r1
 }
 
-// IsUpToDate provides a mock function with given fields: connector, config
-func (_m *MockHighLevelClient) IsUpToDate(connector string, config map[string]interface{}) (bool, error) {
-	ret := _m.Called(connector, config)
+// GetAllTopics provides a mock function with given fields:
+func (_m *MockHighLevelClient) GetAllTopics() (GetAllTopicsResponse, error) {
+	ret := _m.Called()
 
-	var r0 bool
-	if rf, ok := ret.Get(0).(func(string, map[string]interface{}) bool); ok {
-		r0 = rf(connector, config)
+	var r0 GetAllTopicsResponse
+	if rf, ok := ret.Get(0).(func() GetAllTopicsResponse); ok {
+		r0 = rf()
 	} else {
-		r0 = ret.Get(0).(bool)
+		r0 = ret.Get(0).(GetAllTopicsResponse)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(string, map[string]interface{}) error); ok {
-		r1 = rf(connector, config)
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -229,20 +488,20 @@ func (_m *MockHighLevelClient) IsUpToDate(connector string, config map[string]in
 	return r0, r1
 }
 
-// PauseConnector provides a mock function with given fields: req, sync
-func (_m *MockHighLevelClient) PauseConnector(req ConnectorRequest, sync bool) (EmptyResponse, error) {
-	ret := _m.Called(req, sync)
+// GetAllTopicsContext provides a mock function with given fields: ctx
+func (_m *MockHighLevelClient) GetAllTopicsContext(ctx context.Context) (GetAllTopicsResponse, error) {
+	ret := _m.Called(ctx)
 
-	var r0 EmptyResponse
-	if rf, ok := ret.Get(0).(func(ConnectorRequest, bool) EmptyResponse); ok {
-		r0 = rf(req, sync)
+	var r0 GetAllTopicsResponse
+	if rf, ok := ret.Get(0).(func(context.Context) GetAllTopicsResponse); ok {
+		r0 = rf(ctx)
 	} else {
-		r0 = ret.Get(0).(EmptyResponse)
+		r0 = ret.Get(0).(GetAllTopicsResponse)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(ConnectorRequest, bool) error); ok {
-		r1 = rf(req, sync)
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -250,15 +509,15 @@ func (_m *MockHighLevelClient) PauseConnector(req ConnectorRequest, sync bool) (
 	return r0, r1
 }
 
-// RestartConnector provides a mock function with given fields: req
-func (_m *MockHighLevelClient) RestartConnector(req ConnectorRequest) (EmptyResponse, error) {
+// GetConnector provides a mock function with given fields: req
+func (_m *MockHighLevelClient) GetConnector(req ConnectorRequest) (ConnectorResponse, error) {
 	ret := _m.Called(req)
 
-	var r0 EmptyResponse
-	if rf, ok := ret.Get(0).(func(ConnectorRequest) EmptyResponse); ok {
+	var r0 ConnectorResponse
+	if rf, ok := ret.Get(0).(func(ConnectorRequest) ConnectorResponse); ok {
 		r0 = rf(req)
 	} else {
-		r0 = ret.Get(0).(EmptyResponse)
+		r0 = ret.Get(0).(ConnectorResponse)
 	}
 
 	var r1 error
@@ -271,19 +530,19 @@ func (_m *MockHighLevelClient) RestartConnector(req ConnectorRequest) (EmptyResp
 	return r0, r1
 }
 
-// RestartTask provides a mock function with given fields: req
-func (_m *MockHighLevelClient) RestartTask(req TaskRequest) (EmptyResponse, error) {
+// GetConnectorConfig provides a mock function with given fields: req
+func (_m *MockHighLevelClient) GetConnectorConfig(req ConnectorRequest) (GetConnectorConfigResponse, error) {
 	ret := _m.Called(req)
 
-	var r0 EmptyResponse
-	if rf, ok := ret.Get(0).(func(TaskRequest) EmptyResponse); ok {
+	var r0 GetConnectorConfigResponse
+	if rf, ok := ret.Get(0).(func(ConnectorRequest) GetConnectorConfigResponse); ok {
 		r0 = rf(req)
 	} else {
-		r0 = ret.Get(0).(EmptyResponse)
+		r0 = ret.Get(0).(GetConnectorConfigResponse)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(TaskRequest) error); ok {
+	if rf, ok := ret.Get(1).(func(ConnectorRequest) error); ok {
 		r1 = rf(req)
 	} else {
 		r1 = ret.Error(1)
@@ -292,13 +551,815 @@ func (_m *MockHighLevelClient) RestartTask(req TaskRequest) (EmptyResponse, erro
 	return r0, r1
 }
 
-// ResumeConnector provides a mock function with given fields: req, sync
-func (_m *MockHighLevelClient) ResumeConnector(req ConnectorRequest, sync bool) (EmptyResponse, error) {
-	ret := _m.Called(req, sync)
+// GetConnectorConfigContext provides a mock function with given fields: ctx, req
+func (_m *MockHighLevelClient) GetConnectorConfigContext(ctx context.Context, req ConnectorRequest) (GetConnectorConfigResponse, error) {
+	ret := _m.Called(ctx, req)
 
-	var r0 EmptyResponse
-	if rf, ok := ret.Get(0).(func(ConnectorRequest, bool) EmptyResponse); ok {
-		r0 = rf(req, sync)
+	var r0 GetConnectorConfigResponse
+	if rf, ok := ret.Get(0).(func(context.Context, ConnectorRequest) GetConnectorConfigResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Get(0).(GetConnectorConfigResponse)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, ConnectorRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetConnectorContext provides a mock function with given fields: ctx, req
+func (_m *MockHighLevelClient) GetConnectorContext(ctx context.Context, req ConnectorRequest) (ConnectorResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 ConnectorResponse
+	if rf, ok := ret.Get(0).(func(context.Context, ConnectorRequest) ConnectorResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Get(0).(ConnectorResponse)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, ConnectorRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetConnectorOffsets provides a mock function with given fields: req
+func (_m *MockHighLevelClient) GetConnectorOffsets(req ConnectorRequest) (GetConnectorOffsetsResponse, error) {
+	ret := _m.Called(req)
+
+	var r0 GetConnectorOffsetsResponse
+	if rf, ok := ret.Get(0).(func(ConnectorRequest) GetConnectorOffsetsResponse); ok {
+		r0 = rf(req)
+	} else {
+		r0 = ret.Get(0).(GetConnectorOffsetsResponse)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(ConnectorRequest) error); ok {
+		r1 = rf(req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetConnectorOffsetsContext provides a mock function with given fields: ctx, req
+func (_m *MockHighLevelClient) GetConnectorOffsetsContext(ctx context.Context, req ConnectorRequest) (GetConnectorOffsetsResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 GetConnectorOffsetsResponse
+	if rf, ok := ret.Get(0).(func(context.Context, ConnectorRequest) GetConnectorOffsetsResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Get(0).(GetConnectorOffsetsResponse)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, ConnectorRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetConnectorPlugins provides a mock function with given fields:
+func (_m *MockHighLevelClient) GetConnectorPlugins() (GetConnectorPluginsResponse, error) {
+	ret := _m.Called()
+
+	var r0 GetConnectorPluginsResponse
+	if rf, ok := ret.Get(0).(func() GetConnectorPluginsResponse); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(GetConnectorPluginsResponse)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetConnectorPluginsContext provides a mock function with given fields: ctx
+func (_m *MockHighLevelClient) GetConnectorPluginsContext(ctx context.Context) (GetConnectorPluginsResponse, error) {
+	ret := _m.Called(ctx)
+
+	var r0 GetConnectorPluginsResponse
+	if rf, ok := ret.Get(0).(func(context.Context) GetConnectorPluginsResponse); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(GetConnectorPluginsResponse)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetConnectorStatus provides a mock function with given fields: req
+func (_m *MockHighLevelClient) GetConnectorStatus(req ConnectorRequest) (GetConnectorStatusResponse, error) {
+	ret := _m.Called(req)
+
+	var r0 GetConnectorStatusResponse
+	if rf, ok := ret.Get(0).(func(ConnectorRequest) GetConnectorStatusResponse); ok {
+		r0 = rf(req)
+	} else {
+		r0 = ret.Get(0).(GetConnectorStatusResponse)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(ConnectorRequest) error); ok {
+		r1 = rf(req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetConnectorStatusContext provides a mock function with given fields: ctx, req
+func (_m *MockHighLevelClient) GetConnectorStatusContext(ctx context.Context, req ConnectorRequest) (GetConnectorStatusResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 GetConnectorStatusResponse
+	if rf, ok := ret.Get(0).(func(context.Context, ConnectorRequest) GetConnectorStatusResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Get(0).(GetConnectorStatusResponse)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, ConnectorRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetConnectorTopics provides a mock function with given fields: req
+func (_m *MockHighLevelClient) GetConnectorTopics(req ConnectorRequest) (GetConnectorTopicsResponse, error) {
+	ret := _m.Called(req)
+
+	var r0 GetConnectorTopicsResponse
+	if rf, ok := ret.Get(0).(func(ConnectorRequest) GetConnectorTopicsResponse); ok {
+		r0 = rf(req)
+	} else {
+		r0 = ret.Get(0).(GetConnectorTopicsResponse)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(ConnectorRequest) error); ok {
+		r1 = rf(req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetConnectorTopicsContext provides a mock function with given fields: ctx, req
+func (_m *MockHighLevelClient) GetConnectorTopicsContext(ctx context.Context, req ConnectorRequest) (GetConnectorTopicsResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 GetConnectorTopicsResponse
+	if rf, ok := ret.Get(0).(func(context.Context, ConnectorRequest) GetConnectorTopicsResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Get(0).(GetConnectorTopicsResponse)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, ConnectorRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetLogger provides a mock function with given fields: req
+func (_m *MockHighLevelClient) GetLogger(req LoggerRequest) (GetLoggerResponse, error) {
+	ret := _m.Called(req)
+
+	var r0 GetLoggerResponse
+	if rf, ok := ret.Get(0).(func(LoggerRequest) GetLoggerResponse); ok {
+		r0 = rf(req)
+	} else {
+		r0 = ret.Get(0).(GetLoggerResponse)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(LoggerRequest) error); ok {
+		r1 = rf(req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetLoggerContext provides a mock function with given fields: ctx, req
+func (_m *MockHighLevelClient) GetLoggerContext(ctx context.Context, req LoggerRequest) (GetLoggerResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 GetLoggerResponse
+	if rf, ok := ret.Get(0).(func(context.Context, LoggerRequest) GetLoggerResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Get(0).(GetLoggerResponse)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, LoggerRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetLoggers provides a mock function with given fields:
+func (_m *MockHighLevelClient) GetLoggers() (GetLoggersResponse, error) {
+	ret := _m.Called()
+
+	var r0 GetLoggersResponse
+	if rf, ok := ret.Get(0).(func() GetLoggersResponse); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(GetLoggersResponse)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetLoggersContext provides a mock function with given fields: ctx
+func (_m *MockHighLevelClient) GetLoggersContext(ctx context.Context) (GetLoggersResponse, error) {
+	ret := _m.Called(ctx)
+
+	var r0 GetLoggersResponse
+	if rf, ok := ret.Get(0).(func(context.Context) GetLoggersResponse); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(GetLoggersResponse)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTaskStatus provides a mock function with given fields: req
+func (_m *MockHighLevelClient) GetTaskStatus(req TaskRequest) (TaskStatusResponse, error) {
+	ret := _m.Called(req)
+
+	var r0 TaskStatusResponse
+	if rf, ok := ret.Get(0).(func(TaskRequest) TaskStatusResponse); ok {
+		r0 = rf(req)
+	} else {
+		r0 = ret.Get(0).(TaskStatusResponse)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(TaskRequest) error); ok {
+		r1 = rf(req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTaskStatusContext provides a mock function with given fields: ctx, req
+func (_m *MockHighLevelClient) GetTaskStatusContext(ctx context.Context, req TaskRequest) (TaskStatusResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 TaskStatusResponse
+	if rf, ok := ret.Get(0).(func(context.Context, TaskRequest) TaskStatusResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Get(0).(TaskStatusResponse)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, TaskRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetWorkerInfo provides a mock function with given fields:
+func (_m *MockHighLevelClient) GetWorkerInfo() (WorkerInfoResponse, error) {
+	ret := _m.Called()
+
+	var r0 WorkerInfoResponse
+	if rf, ok := ret.Get(0).(func() WorkerInfoResponse); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(WorkerInfoResponse)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetWorkerInfoContext provides a mock function with given fields: ctx
+func (_m *MockHighLevelClient) GetWorkerInfoContext(ctx context.Context) (WorkerInfoResponse, error) {
+	ret := _m.Called(ctx)
+
+	var r0 WorkerInfoResponse
+	if rf, ok := ret.Get(0).(func(context.Context) WorkerInfoResponse); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(WorkerInfoResponse)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IsUpToDate provides a mock function with given fields: connector, config
+func (_m *MockHighLevelClient) IsUpToDate(connector string, config map[string]interface{}) (bool, error) {
+	ret := _m.Called(connector, config)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(string, map[string]interface{}) bool); ok {
+		r0 = rf(connector, config)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, map[string]interface{}) error); ok {
+		r1 = rf(connector, config)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IsUpToDateContext provides a mock function with given fields: ctx, connector, config
+func (_m *MockHighLevelClient) IsUpToDateContext(ctx context.Context, connector string, config map[string]interface{}) (bool, error) {
+	ret := _m.Called(ctx, connector, config)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(context.Context, string, map[string]interface{}) bool); ok {
+		r0 = rf(ctx, connector, config)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, map[string]interface{}) error); ok {
+		r1 = rf(ctx, connector, config)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PauseConnector provides a mock function with given fields: req, sync
+func (_m *MockHighLevelClient) PauseConnector(req ConnectorRequest, sync bool) (EmptyResponse, error) {
+	ret := _m.Called(req, sync)
+
+	var r0 EmptyResponse
+	if rf, ok := ret.Get(0).(func(ConnectorRequest, bool) EmptyResponse); ok {
+		r0 = rf(req, sync)
+	} else {
+		r0 = ret.Get(0).(EmptyResponse)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(ConnectorRequest, bool) error); ok {
+		r1 = rf(req, sync)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PauseConnectorContext provides a mock function with given fields: ctx, req, sync
+func (_m *MockHighLevelClient) PauseConnectorContext(ctx context.Context, req ConnectorRequest, sync bool) (EmptyResponse, error) {
+	ret := _m.Called(ctx, req, sync)
+
+	var r0 EmptyResponse
+	if rf, ok := ret.Get(0).(func(context.Context, ConnectorRequest, bool) EmptyResponse); ok {
+		r0 = rf(ctx, req, sync)
+	} else {
+		r0 = ret.Get(0).(EmptyResponse)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, ConnectorRequest, bool) error); ok {
+		r1 = rf(ctx, req, sync)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Plan provides a mock function with given fields: desired
+func (_m *MockHighLevelClient) Plan(desired []CreateConnectorRequest) (Plan, error) {
+	ret := _m.Called(desired)
+
+	var r0 Plan
+	if rf, ok := ret.Get(0).(func([]CreateConnectorRequest) Plan); ok {
+		r0 = rf(desired)
+	} else {
+		r0 = ret.Get(0).(Plan)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func([]CreateConnectorRequest) error); ok {
+		r1 = rf(desired)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PlanContext provides a mock function with given fields: ctx, desired
+func (_m *MockHighLevelClient) PlanContext(ctx context.Context, desired []CreateConnectorRequest) (Plan, error) {
+	ret := _m.Called(ctx, desired)
+
+	var r0 Plan
+	if rf, ok := ret.Get(0).(func(context.Context, []CreateConnectorRequest) Plan); ok {
+		r0 = rf(ctx, desired)
+	} else {
+		r0 = ret.Get(0).(Plan)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, []CreateConnectorRequest) error); ok {
+		r1 = rf(ctx, desired)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PlanReconcile provides a mock function with given fields: req
+func (_m *MockHighLevelClient) PlanReconcile(req ReconcileRequest) (Plan, error) {
+	ret := _m.Called(req)
+
+	var r0 Plan
+	if rf, ok := ret.Get(0).(func(ReconcileRequest) Plan); ok {
+		r0 = rf(req)
+	} else {
+		r0 = ret.Get(0).(Plan)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(ReconcileRequest) error); ok {
+		r1 = rf(req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PlanReconcileContext provides a mock function with given fields: ctx, req
+func (_m *MockHighLevelClient) PlanReconcileContext(ctx context.Context, req ReconcileRequest) (Plan, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 Plan
+	if rf, ok := ret.Get(0).(func(context.Context, ReconcileRequest) Plan); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Get(0).(Plan)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, ReconcileRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Reconcile provides a mock function with given fields: req
+func (_m *MockHighLevelClient) Reconcile(req ReconcileRequest) ([]DeployResult, error) {
+	ret := _m.Called(req)
+
+	var r0 []DeployResult
+	if rf, ok := ret.Get(0).(func(ReconcileRequest) []DeployResult); ok {
+		r0 = rf(req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]DeployResult)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(ReconcileRequest) error); ok {
+		r1 = rf(req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReconcileContext provides a mock function with given fields: ctx, req
+func (_m *MockHighLevelClient) ReconcileContext(ctx context.Context, req ReconcileRequest) ([]DeployResult, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 []DeployResult
+	if rf, ok := ret.Get(0).(func(context.Context, ReconcileRequest) []DeployResult); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]DeployResult)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, ReconcileRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ResetConnectorOffsets provides a mock function with given fields: req, sync
+func (_m *MockHighLevelClient) ResetConnectorOffsets(req ConnectorRequest, sync bool) (ConnectorOffsetsMessageResponse, error) {
+	ret := _m.Called(req, sync)
+
+	var r0 ConnectorOffsetsMessageResponse
+	if rf, ok := ret.Get(0).(func(ConnectorRequest, bool) ConnectorOffsetsMessageResponse); ok {
+		r0 = rf(req, sync)
+	} else {
+		r0 = ret.Get(0).(ConnectorOffsetsMessageResponse)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(ConnectorRequest, bool) error); ok {
+		r1 = rf(req, sync)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ResetConnectorOffsetsContext provides a mock function with given fields: ctx, req, sync
+func (_m *MockHighLevelClient) ResetConnectorOffsetsContext(ctx context.Context, req ConnectorRequest, sync bool) (ConnectorOffsetsMessageResponse, error) {
+	ret := _m.Called(ctx, req, sync)
+
+	var r0 ConnectorOffsetsMessageResponse
+	if rf, ok := ret.Get(0).(func(context.Context, ConnectorRequest, bool) ConnectorOffsetsMessageResponse); ok {
+		r0 = rf(ctx, req, sync)
+	} else {
+		r0 = ret.Get(0).(ConnectorOffsetsMessageResponse)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, ConnectorRequest, bool) error); ok {
+		r1 = rf(ctx, req, sync)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ResetConnectorTopics provides a mock function with given fields: req
+func (_m *MockHighLevelClient) ResetConnectorTopics(req ConnectorRequest) (EmptyResponse, error) {
+	ret := _m.Called(req)
+
+	var r0 EmptyResponse
+	if rf, ok := ret.Get(0).(func(ConnectorRequest) EmptyResponse); ok {
+		r0 = rf(req)
+	} else {
+		r0 = ret.Get(0).(EmptyResponse)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(ConnectorRequest) error); ok {
+		r1 = rf(req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ResetConnectorTopicsContext provides a mock function with given fields: ctx, req
+func (_m *MockHighLevelClient) ResetConnectorTopicsContext(ctx context.Context, req ConnectorRequest) (EmptyResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 EmptyResponse
+	if rf, ok := ret.Get(0).(func(context.Context, ConnectorRequest) EmptyResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Get(0).(EmptyResponse)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, ConnectorRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RestartConnector provides a mock function with given fields: req
+func (_m *MockHighLevelClient) RestartConnector(req ConnectorRequest) (EmptyResponse, error) {
+	ret := _m.Called(req)
+
+	var r0 EmptyResponse
+	if rf, ok := ret.Get(0).(func(ConnectorRequest) EmptyResponse); ok {
+		r0 = rf(req)
+	} else {
+		r0 = ret.Get(0).(EmptyResponse)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(ConnectorRequest) error); ok {
+		r1 = rf(req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RestartConnectorContext provides a mock function with given fields: ctx, req
+func (_m *MockHighLevelClient) RestartConnectorContext(ctx context.Context, req ConnectorRequest) (EmptyResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 EmptyResponse
+	if rf, ok := ret.Get(0).(func(context.Context, ConnectorRequest) EmptyResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Get(0).(EmptyResponse)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, ConnectorRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RestartConnectorWithOptions provides a mock function with given fields: req, sync
+func (_m *MockHighLevelClient) RestartConnectorWithOptions(req RestartConnectorRequest, sync bool) (RestartConnectorResponse, error) {
+	ret := _m.Called(req, sync)
+
+	var r0 RestartConnectorResponse
+	if rf, ok := ret.Get(0).(func(RestartConnectorRequest, bool) RestartConnectorResponse); ok {
+		r0 = rf(req, sync)
+	} else {
+		r0 = ret.Get(0).(RestartConnectorResponse)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(RestartConnectorRequest, bool) error); ok {
+		r1 = rf(req, sync)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RestartConnectorWithOptionsContext provides a mock function with given fields: ctx, req, sync
+func (_m *MockHighLevelClient) RestartConnectorWithOptionsContext(ctx context.Context, req RestartConnectorRequest, sync bool) (RestartConnectorResponse, error) {
+	ret := _m.Called(ctx, req, sync)
+
+	var r0 RestartConnectorResponse
+	if rf, ok := ret.Get(0).(func(context.Context, RestartConnectorRequest, bool) RestartConnectorResponse); ok {
+		r0 = rf(ctx, req, sync)
+	} else {
+		r0 = ret.Get(0).(RestartConnectorResponse)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, RestartConnectorRequest, bool) error); ok {
+		r1 = rf(ctx, req, sync)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RestartTask provides a mock function with given fields: req
+func (_m *MockHighLevelClient) RestartTask(req TaskRequest) (EmptyResponse, error) {
+	ret := _m.Called(req)
+
+	var r0 EmptyResponse
+	if rf, ok := ret.Get(0).(func(TaskRequest) EmptyResponse); ok {
+		r0 = rf(req)
+	} else {
+		r0 = ret.Get(0).(EmptyResponse)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(TaskRequest) error); ok {
+		r1 = rf(req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RestartTaskContext provides a mock function with given fields: ctx, req
+func (_m *MockHighLevelClient) RestartTaskContext(ctx context.Context, req TaskRequest) (EmptyResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 EmptyResponse
+	if rf, ok := ret.Get(0).(func(context.Context, TaskRequest) EmptyResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Get(0).(EmptyResponse)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, TaskRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ResumeConnector provides a mock function with given fields: req, sync
+func (_m *MockHighLevelClient) ResumeConnector(req ConnectorRequest, sync bool) (EmptyResponse, error) {
+	ret := _m.Called(req, sync)
+
+	var r0 EmptyResponse
+	if rf, ok := ret.Get(0).(func(ConnectorRequest, bool) EmptyResponse); ok {
+		r0 = rf(req, sync)
 	} else {
 		r0 = ret.Get(0).(EmptyResponse)
 	}
@@ -313,6 +1374,27 @@ func (_m *MockHighLevelClient) ResumeConnector(req ConnectorRequest, sync bool)
 	return r0, r1
 }
 
+// ResumeConnectorContext provides a mock function with given fields: ctx, req, sync
+func (_m *MockHighLevelClient) ResumeConnectorContext(ctx context.Context, req ConnectorRequest, sync bool) (EmptyResponse, error) {
+	ret := _m.Called(ctx, req, sync)
+
+	var r0 EmptyResponse
+	if rf, ok := ret.Get(0).(func(context.Context, ConnectorRequest, bool) EmptyResponse); ok {
+		r0 = rf(ctx, req, sync)
+	} else {
+		r0 = ret.Get(0).(EmptyResponse)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, ConnectorRequest, bool) error); ok {
+		r1 = rf(ctx, req, sync)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // SetBasicAuth provides a mock function with given fields: username, password
 func (_m *MockHighLevelClient) SetBasicAuth(username string, password string) {
 	_m.Called(username, password)
@@ -334,16 +1416,238 @@ func (_m *MockHighLevelClient) SetDebug() {
 	_m.Called()
 }
 
+// SetDeployOptions provides a mock function with given fields: options
+func (_m *MockHighLevelClient) SetDeployOptions(options DeployOptions) {
+	_m.Called(options)
+}
+
+// SetDesiredState provides a mock function with given fields: req, state, sync
+func (_m *MockHighLevelClient) SetDesiredState(req ConnectorRequest, state string, sync bool) (EmptyResponse, error) {
+	ret := _m.Called(req, state, sync)
+
+	var r0 EmptyResponse
+	if rf, ok := ret.Get(0).(func(ConnectorRequest, string, bool) EmptyResponse); ok {
+		r0 = rf(req, state, sync)
+	} else {
+		r0 = ret.Get(0).(EmptyResponse)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(ConnectorRequest, string, bool) error); ok {
+		r1 = rf(req, state, sync)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SetDesiredStateContext provides a mock function with given fields: ctx, req, state, sync
+func (_m *MockHighLevelClient) SetDesiredStateContext(ctx context.Context, req ConnectorRequest, state string, sync bool) (EmptyResponse, error) {
+	ret := _m.Called(ctx, req, state, sync)
+
+	var r0 EmptyResponse
+	if rf, ok := ret.Get(0).(func(context.Context, ConnectorRequest, string, bool) EmptyResponse); ok {
+		r0 = rf(ctx, req, state, sync)
+	} else {
+		r0 = ret.Get(0).(EmptyResponse)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, ConnectorRequest, string, bool) error); ok {
+		r1 = rf(ctx, req, state, sync)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SetHeader provides a mock function with given fields: name, value
+func (_m *MockHighLevelClient) SetHeader(name string, value string) {
+	_m.Called(name, value)
+}
+
+// SetHealthCheck provides a mock function with given fields: options
+func (_m *MockHighLevelClient) SetHealthCheck(options HealthCheckOptions) {
+	_m.Called(options)
+}
+
 // SetInsecureSSL provides a mock function with given fields:
 func (_m *MockHighLevelClient) SetInsecureSSL() {
 	_m.Called()
 }
 
+// SetLogLevel provides a mock function with given fields: req
+func (_m *MockHighLevelClient) SetLogLevel(req SetLogLevelRequest) (SetLogLevelResponse, error) {
+	ret := _m.Called(req)
+
+	var r0 SetLogLevelResponse
+	if rf, ok := ret.Get(0).(func(SetLogLevelRequest) SetLogLevelResponse); ok {
+		r0 = rf(req)
+	} else {
+		r0 = ret.Get(0).(SetLogLevelResponse)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(SetLogLevelRequest) error); ok {
+		r1 = rf(req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SetLogLevelContext provides a mock function with given fields: ctx, req
+func (_m *MockHighLevelClient) SetLogLevelContext(ctx context.Context, req SetLogLevelRequest) (SetLogLevelResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 SetLogLevelResponse
+	if rf, ok := ret.Get(0).(func(context.Context, SetLogLevelRequest) SetLogLevelResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Get(0).(SetLogLevelResponse)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, SetLogLevelRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SetLogLevelTemporarily provides a mock function with given fields: req, duration
+func (_m *MockHighLevelClient) SetLogLevelTemporarily(req SetLogLevelRequest, duration time.Duration) (*TemporaryLogLevel, error) {
+	ret := _m.Called(req, duration)
+
+	var r0 *TemporaryLogLevel
+	if rf, ok := ret.Get(0).(func(SetLogLevelRequest, time.Duration) *TemporaryLogLevel); ok {
+		r0 = rf(req, duration)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*TemporaryLogLevel)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(SetLogLevelRequest, time.Duration) error); ok {
+		r1 = rf(req, duration)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SetLogLevelTemporarilyContext provides a mock function with given fields: ctx, req, duration
+func (_m *MockHighLevelClient) SetLogLevelTemporarilyContext(ctx context.Context, req SetLogLevelRequest, duration time.Duration) (*TemporaryLogLevel, error) {
+	ret := _m.Called(ctx, req, duration)
+
+	var r0 *TemporaryLogLevel
+	if rf, ok := ret.Get(0).(func(context.Context, SetLogLevelRequest, time.Duration) *TemporaryLogLevel); ok {
+		r0 = rf(ctx, req, duration)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*TemporaryLogLevel)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, SetLogLevelRequest, time.Duration) error); ok {
+		r1 = rf(ctx, req, duration)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SetLogger provides a mock function with given fields: logger
+func (_m *MockHighLevelClient) SetLogger(logger Logger) {
+	_m.Called(logger)
+}
+
+// SetMaxInFlight provides a mock function with given fields: max
+func (_m *MockHighLevelClient) SetMaxInFlight(max int) {
+	_m.Called(max)
+}
+
+// SetNotFoundAsError provides a mock function with given fields: enabled
+func (_m *MockHighLevelClient) SetNotFoundAsError(enabled bool) {
+	_m.Called(enabled)
+}
+
 // SetParallelism provides a mock function with given fields: value
 func (_m *MockHighLevelClient) SetParallelism(value int) {
 	_m.Called(value)
 }
 
+// SetRateLimit provides a mock function with given fields: requestsPerSecond, burst
+func (_m *MockHighLevelClient) SetRateLimit(requestsPerSecond float64, burst int) {
+	_m.Called(requestsPerSecond, burst)
+}
+
+// SetTokenSource provides a mock function with given fields: source
+func (_m *MockHighLevelClient) SetTokenSource(source TokenSource) {
+	_m.Called(source)
+}
+
+// SetVersionCheck provides a mock function with given fields: enabled
+func (_m *MockHighLevelClient) SetVersionCheck(enabled bool) {
+	_m.Called(enabled)
+}
+
+// SetWaitOptions provides a mock function with given fields: options
+func (_m *MockHighLevelClient) SetWaitOptions(options WaitOptions) {
+	_m.Called(options)
+}
+
+// StopConnector provides a mock function with given fields: req, sync
+func (_m *MockHighLevelClient) StopConnector(req ConnectorRequest, sync bool) (EmptyResponse, error) {
+	ret := _m.Called(req, sync)
+
+	var r0 EmptyResponse
+	if rf, ok := ret.Get(0).(func(ConnectorRequest, bool) EmptyResponse); ok {
+		r0 = rf(req, sync)
+	} else {
+		r0 = ret.Get(0).(EmptyResponse)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(ConnectorRequest, bool) error); ok {
+		r1 = rf(req, sync)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StopConnectorContext provides a mock function with given fields: ctx, req, sync
+func (_m *MockHighLevelClient) StopConnectorContext(ctx context.Context, req ConnectorRequest, sync bool) (EmptyResponse, error) {
+	ret := _m.Called(ctx, req, sync)
+
+	var r0 EmptyResponse
+	if rf, ok := ret.Get(0).(func(context.Context, ConnectorRequest, bool) EmptyResponse); ok {
+		r0 = rf(ctx, req, sync)
+	} else {
+		r0 = ret.Get(0).(EmptyResponse)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, ConnectorRequest, bool) error); ok {
+		r1 = rf(ctx, req, sync)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // UpdateConnector provides a mock function with given fields: req, sync
 func (_m *MockHighLevelClient) UpdateConnector(req CreateConnectorRequest, sync bool) (ConnectorResponse, error) {
 	ret := _m.Called(req, sync)
@@ -364,3 +1668,94 @@ func (_m *MockHighLevelClient) UpdateConnector(req CreateConnectorRequest, sync
 
 	return r0, r1
 }
+
+// UpdateConnectorContext provides a mock function with given fields: ctx, req, sync
+func (_m *MockHighLevelClient) UpdateConnectorContext(ctx context.Context, req CreateConnectorRequest, sync bool) (ConnectorResponse, error) {
+	ret := _m.Called(ctx, req, sync)
+
+	var r0 ConnectorResponse
+	if rf, ok := ret.Get(0).(func(context.Context, CreateConnectorRequest, bool) ConnectorResponse); ok {
+		r0 = rf(ctx, req, sync)
+	} else {
+		r0 = ret.Get(0).(ConnectorResponse)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, CreateConnectorRequest, bool) error); ok {
+		r1 = rf(ctx, req, sync)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ValidateConnectorConfig provides a mock function with given fields: req
+func (_m *MockHighLevelClient) ValidateConnectorConfig(req ValidateConnectorConfigRequest) (ValidateConnectorConfigResponse, error) {
+	ret := _m.Called(req)
+
+	var r0 ValidateConnectorConfigResponse
+	if rf, ok := ret.Get(0).(func(ValidateConnectorConfigRequest) ValidateConnectorConfigResponse); ok {
+		r0 = rf(req)
+	} else {
+		r0 = ret.Get(0).(ValidateConnectorConfigResponse)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(ValidateConnectorConfigRequest) error); ok {
+		r1 = rf(req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ValidateConnectorConfigContext provides a mock function with given fields: ctx, req
+func (_m *MockHighLevelClient) ValidateConnectorConfigContext(ctx context.Context, req ValidateConnectorConfigRequest) (ValidateConnectorConfigResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 ValidateConnectorConfigResponse
+	if rf, ok := ret.Get(0).(func(context.Context, ValidateConnectorConfigRequest) ValidateConnectorConfigResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Get(0).(ValidateConnectorConfigResponse)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, ValidateConnectorConfigRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ValidateMultipleConnector provides a mock function with given fields: connectors
+func (_m *MockHighLevelClient) ValidateMultipleConnector(connectors []CreateConnectorRequest) error {
+	ret := _m.Called(connectors)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func([]CreateConnectorRequest) error); ok {
+		r0 = rf(connectors)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ValidateMultipleConnectorContext provides a mock function with given fields: ctx, connectors
+func (_m *MockHighLevelClient) ValidateMultipleConnectorContext(ctx context.Context, connectors []CreateConnectorRequest) error {
+	ret := _m.Called(ctx, connectors)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []CreateConnectorRequest) error); ok {
+		r0 = rf(ctx, connectors)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
@@ -0,0 +1,260 @@
+package connectors
+
+import (
+	"crypto/tls"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/pkg/errors"
+	"gopkg.in/resty.v1"
+)
+
+//Option configures a client created by NewClientWithOptions
+type Option func(o *clientOptions) error
+
+type clientOptions struct {
+	timeout        time.Duration
+	retryPolicy    RetryPolicy
+	userAgent      string
+	tlsConfig      *tls.Config
+	proxy          string
+	httpClient     *http.Client
+	transport      http.RoundTripper
+	parallelism    int
+	waitOptions    WaitOptions
+	workerCooldown time.Duration
+	workerCheck    time.Duration
+	tokenSource    TokenSource
+	interceptors   []Interceptor
+	logger         Logger
+	rateLimit      float64
+	rateBurst      int
+	maxInFlight    int
+	healthCheck    HealthCheckOptions
+	deployOptions  DeployOptions
+}
+
+func defaultClientOptions() clientOptions {
+	return clientOptions{
+		timeout: 10 * time.Second,
+		retryPolicy: DefaultRetryPolicy{
+			MaxRetries:  5,
+			WaitTime:    500 * time.Millisecond,
+			MaxWaitTime: 5 * time.Second,
+		},
+		parallelism:    3,
+		workerCooldown: 30 * time.Second,
+		workerCheck:    30 * time.Second,
+	}
+}
+
+//WithTimeout sets the timeout of a single request
+//Default to 10 seconds
+func WithTimeout(timeout time.Duration) Option {
+	return func(o *clientOptions) error {
+		if timeout < 0 {
+			return errors.Errorf("invalid timeout: %v", timeout)
+		}
+		o.timeout = timeout
+		return nil
+	}
+}
+
+//WithRetry sets how many times DefaultRetryPolicy retries a request, and how long it waits between attempts
+//Default to 5 retries, waiting between 500ms and 5s
+func WithRetry(count int, waitTime time.Duration, maxWaitTime time.Duration) Option {
+	return func(o *clientOptions) error {
+		if count < 0 {
+			return errors.Errorf("invalid retry count: %d", count)
+		}
+		o.retryPolicy = DefaultRetryPolicy{MaxRetries: count, WaitTime: waitTime, MaxWaitTime: maxWaitTime}
+		return nil
+	}
+}
+
+//WithRetryPolicy replaces DefaultRetryPolicy, which decides whether a failed request is sent again
+func WithRetryPolicy(policy RetryPolicy) Option {
+	return func(o *clientOptions) error {
+		if policy == nil {
+			return errors.New("retry policy is nil")
+		}
+		o.retryPolicy = policy
+		return nil
+	}
+}
+
+//WithTokenSource sends a bearer token provided by source with every request, see StaticToken, FileToken and ClientCredentialsToken
+func WithTokenSource(source TokenSource) Option {
+	return func(o *clientOptions) error {
+		o.tokenSource = source
+		return nil
+	}
+}
+
+//WithInterceptor registers interceptors called around every request, see Interceptor
+func WithInterceptor(interceptors ...Interceptor) Option {
+	return func(o *clientOptions) error {
+		o.interceptors = append(o.interceptors, interceptors...)
+		return nil
+	}
+}
+
+//WithLogger sets the logger receiving events of the client, see Logger
+func WithLogger(logger Logger) Option {
+	return func(o *clientOptions) error {
+		o.logger = logger
+		return nil
+	}
+}
+
+//WithUserAgent sets the User-Agent header of every request
+func WithUserAgent(userAgent string) Option {
+	return func(o *clientOptions) error {
+		o.userAgent = userAgent
+		return nil
+	}
+}
+
+//WithTLSConfig sets the TLS config used to connect to kafka-connect, it replaces the one built by previous TLS options
+//It requires the transport to be an *http.Transport, which is cloned rather than modified
+func WithTLSConfig(config *tls.Config) Option {
+	return func(o *clientOptions) error {
+		if config == nil {
+			return errors.New("TLS config is nil")
+		}
+		// next options may change it
+		o.tlsConfig = config.Clone()
+		return nil
+	}
+}
+
+//WithProxy sends every request through the given proxy, e.g. "http://proxy:8888"
+//It requires the transport to be an *http.Transport, which is cloned rather than modified
+func WithProxy(proxyURL string) Option {
+	return func(o *clientOptions) error {
+		if _, err := url.Parse(proxyURL); err != nil {
+			return errors.Wrap(err, "invalid proxy url")
+		}
+		o.proxy = proxyURL
+		return nil
+	}
+}
+
+//WithHTTPClient makes requests go through the given http client, e.g. one shared with the rest of an application
+//The client is copied, so that settings of the connectors client do not leak into it
+func WithHTTPClient(client *http.Client) Option {
+	return func(o *clientOptions) error {
+		if client == nil {
+			return errors.New("http client is nil")
+		}
+		o.httpClient = client
+		return nil
+	}
+}
+
+//WithTransport makes requests go through the given transport, e.g. one adding tracing or mTLS
+func WithTransport(transport http.RoundTripper) Option {
+	return func(o *clientOptions) error {
+		if transport == nil {
+			return errors.New("transport is nil")
+		}
+		o.transport = transport
+		return nil
+	}
+}
+
+//WithParallelism sets the limit of parallel call to kafka-connect server, see SetParallelism
+//Default to 3
+func WithParallelism(value int) Option {
+	return func(o *clientOptions) error {
+		if value < 1 {
+			return errors.Errorf("invalid parallelism: %d", value)
+		}
+		o.parallelism = value
+		return nil
+	}
+}
+
+//WithWaitOptions sets how synchronous operations wait for kafka-connect to apply a change, see SetWaitOptions
+func WithWaitOptions(options WaitOptions) Option {
+	return func(o *clientOptions) error {
+		o.waitOptions = options
+		return nil
+	}
+}
+
+//WithWorkerCooldown sets how long a worker which could not be reached is skipped, see NewMultiWorkerClient
+//Default to 30 seconds
+func WithWorkerCooldown(cooldown time.Duration) Option {
+	return func(o *clientOptions) error {
+		if cooldown < 0 {
+			return errors.Errorf("invalid worker cooldown: %v", cooldown)
+		}
+		o.workerCooldown = cooldown
+		return nil
+	}
+}
+
+//WithWorkerCheckInterval sets how often workers are checked in the background, see NewMultiWorkerClient
+//Unhealthy workers are then skipped before a request fails on them. 0 disables background checks. Default to 30 seconds
+func WithWorkerCheckInterval(interval time.Duration) Option {
+	return func(o *clientOptions) error {
+		if interval < 0 {
+			return errors.Errorf("invalid worker check interval: %v", interval)
+		}
+		o.workerCheck = interval
+		return nil
+	}
+}
+
+// newRestClient builds the resty client used by baseClient
+func (o clientOptions) newRestClient(hostURL string) (*resty.Client, error) {
+	var restClient *resty.Client
+	if o.httpClient != nil {
+		// resty sets timeout and redirect policy on the client it is given
+		httpClient := *o.httpClient
+		restClient = resty.NewWithClient(&httpClient)
+	} else {
+		restClient = resty.New()
+	}
+	if o.transport != nil {
+		restClient.SetTransport(o.transport)
+	}
+
+	if o.tlsConfig != nil || o.proxy != "" {
+		httpClient := restClient.GetClient()
+		var transport *http.Transport
+		switch t := httpClient.Transport.(type) {
+		case nil:
+			transport = http.DefaultTransport.(*http.Transport).Clone()
+		case *http.Transport:
+			// the given transport may be shared, it must not be modified
+			transport = t.Clone()
+		default:
+			return nil, errors.Errorf("TLS config and proxy can only be set on an *http.Transport, got %T", t)
+		}
+		if o.tlsConfig != nil {
+			transport.TLSClientConfig = o.tlsConfig
+		}
+		if o.proxy != "" {
+			proxyURL, err := url.Parse(o.proxy)
+			if err != nil {
+				return nil, errors.Wrap(err, "invalid proxy url")
+			}
+			transport.Proxy = http.ProxyURL(proxyURL)
+		}
+		httpClient.Transport = transport
+	}
+
+	restClient.
+		SetError(ErrorResponse{}).
+		SetHostURL(hostURL).
+		SetHeader("Accept", "application/json").
+		SetTimeout(o.timeout)
+	if o.userAgent != "" {
+		restClient.SetHeader("User-Agent", o.userAgent)
+	}
+
+	return restClient, nil
+}
@@ -0,0 +1,78 @@
+//go:build !integration
+
+package connectors
+
+import (
+	"bytes"
+	"crypto/tls"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type roundTripperFunc func(req *http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func Test_NewClientWithOptions_Transport(t *testing.T) {
+	var received *http.Request
+	transport := roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		received = req
+		return &http.Response{
+			StatusCode: 200,
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+			Body:       ioutil.NopCloser(bytes.NewBufferString(`["test"]`)),
+			Request:    req,
+		}, nil
+	})
+
+	client, err := NewClientWithOptions("http://randomurl",
+		WithTransport(transport),
+		WithUserAgent("my-service/1.0"),
+		WithParallelism(5),
+	)
+	assert.NoError(t, err)
+
+	resp, err := client.GetAll()
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"test"}, resp.Connectors)
+	assert.Equal(t, "my-service/1.0", received.Header.Get("User-Agent"))
+	assert.Equal(t, 5, client.(*highLevelClient).maxParallelRequest)
+}
+
+func Test_NewClientWithOptions_HTTPClient_Not_Modified(t *testing.T) {
+	transport := &http.Transport{}
+	httpClient := &http.Client{Transport: transport}
+
+	client, err := NewClientWithOptions("http://randomurl",
+		WithHTTPClient(httpClient),
+		WithTimeout(time.Minute),
+		WithTLSConfig(&tls.Config{ServerName: "connect"}),
+	)
+	assert.NoError(t, err)
+
+	restClient := client.(*highLevelClient).client.(*baseClient).restClient
+	restTransport := restClient.GetClient().Transport.(*http.Transport)
+	assert.Equal(t, time.Minute, restClient.GetClient().Timeout)
+	assert.Equal(t, "connect", restTransport.TLSClientConfig.ServerName)
+	assert.Equal(t, time.Duration(0), httpClient.Timeout)
+	assert.True(t, restTransport != transport)
+}
+
+func Test_NewClientWithOptions_Invalid(t *testing.T) {
+	_, err := NewClientWithOptions("http://randomurl", WithParallelism(0))
+	assert.Error(t, err)
+
+	// TLS config cannot be set on an unknown transport
+	_, err = NewClientWithOptions("http://randomurl",
+		WithTransport(roundTripperFunc(func(req *http.Request) (*http.Response, error) { return nil, nil })),
+		WithProxy("http://proxy:8888"),
+	)
+	assert.Error(t, err)
+}
@@ -0,0 +1,250 @@
+package connectors
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+//ErrPlanDrift is returned by Apply when connectors changed since the plan was made
+//Use errors.As with *PlanDriftError to know which ones
+var ErrPlanDrift = errors.New("live state changed since planning")
+
+//PlanDriftError is returned by Apply when connectors changed since the plan was made, nothing is applied then
+type PlanDriftError struct {
+	// Connectors are the names of connectors which were created, deleted or reconfigured since planning
+	Connectors []string
+}
+
+func (err *PlanDriftError) Error() string {
+	return fmt.Sprintf("%v: %s", ErrPlanDrift, strings.Join(err.Connectors, ", "))
+}
+
+//Is makes errors.Is(err, ErrPlanDrift) true
+func (err *PlanDriftError) Is(target error) bool {
+	return target == ErrPlanDrift
+}
+
+//Plan lists what Apply will do to each connector, computed by Plan from the live state of kafka-connect
+//It can be saved as json to be applied later, note that it holds the connector configs, secrets included
+type Plan struct {
+	CreatedAt time.Time       `json:"created_at"`
+	Actions   []PlannedAction `json:"actions"`
+}
+
+//PlannedAction is what Apply will do to a connector
+type PlannedAction struct {
+	Name   string       `json:"name"`
+	Action DeployAction `json:"action"`
+	// Diff holds changes to apply to the config, every key when the connector is to be created
+	Diff ConfigDiff `json:"diff"`
+	// Config is the config to deploy
+	Config map[string]interface{} `json:"config"`
+	// LiveConfig is the config of the connector when the plan was made, nil if it did not exist
+	// It is not omitted from json when empty, so that an empty config is not read back as a missing connector
+	LiveConfig map[string]interface{} `json:"live_config"`
+	// DeployOptions are the ones of the request, if any
+	DeployOptions *DeployOptions `json:"deploy_options,omitempty"`
+}
+
+//HasChanges tells whether applying the plan would change anything
+func (p Plan) HasChanges() bool {
+	for _, action := range p.Actions {
+		if action.Action != DeployUnchanged {
+			return true
+		}
+	}
+	return false
+}
+
+//Count returns the number of connectors planned for the given action
+func (p Plan) Count(action DeployAction) int {
+	count := 0
+	for _, planned := range p.Actions {
+		if planned.Action == action {
+			count++
+		}
+	}
+	return count
+}
+
+//Plan computes what deploying connectors would do, without changing anything: which ones would be created,
+//updated or left unchanged, and the config changes. The plan can be executed with Apply
+func (c *highLevelClient) Plan(desired []CreateConnectorRequest) (Plan, error) {
+	return c.PlanContext(context.Background(), desired)
+}
+
+//PlanContext is Plan with a context, which is used to cancel requests
+func (c *highLevelClient) PlanContext(ctx context.Context, desired []CreateConnectorRequest) (plan Plan, err error) {
+	ctx, op := c.startOperation(ctx, "Plan", "")
+	defer func() { op.end(err) }()
+
+	names := make([]string, len(desired))
+	for i, req := range desired {
+		names[i] = req.Name
+	}
+	live, err := c.liveConnectors(ctx, names, false)
+	if err != nil {
+		return Plan{}, err
+	}
+	return planActions(desired, live), nil
+}
+
+// planActions compares the desired connectors with the live ones
+func planActions(desired []CreateConnectorRequest, live map[string]ExpandedConnector) Plan {
+	plan := Plan{CreatedAt: time.Now(), Actions: make([]PlannedAction, len(desired))}
+	for i, req := range desired {
+		planned := PlannedAction{Name: req.Name, Config: req.Config, DeployOptions: req.DeployOptions,
+			Action: DeployCreated, Diff: diffConfig(req.Config, nil)}
+		if existing, ok := live[req.Name]; ok {
+			planned.LiveConfig = existing.Info.Config
+			if planned.LiveConfig == nil {
+				planned.LiveConfig = map[string]interface{}{}
+			}
+			if isConfigUpToDate(req.Name, req.Config, existing.Info.Config) {
+				planned.Action, planned.Diff = DeployUnchanged, ConfigDiff{}
+			} else {
+				planned.Action, planned.Diff = DeployUpdated, diffConfig(req.Config, existing.Info.Config)
+			}
+		}
+		plan.Actions[i] = planned
+	}
+	return plan
+}
+
+//Apply executes a plan made by Plan or PlanReconcile, connectors are deployed in parallel like DeployMultipleConnector does
+//Nothing is done if a connector of the plan changed since planning, the error is then a *PlanDriftError
+//Results are in the order of the plan
+func (c *highLevelClient) Apply(plan Plan) ([]DeployResult, error) {
+	return c.ApplyContext(context.Background(), plan)
+}
+
+//ApplyContext is Apply with a context, which is used to cancel requests and synchronous waits
+func (c *highLevelClient) ApplyContext(ctx context.Context, plan Plan) (results []DeployResult, err error) {
+	ctx, op := c.startOperation(ctx, "Apply", "")
+	defer func() { op.end(err) }()
+
+	names := make([]string, len(plan.Actions))
+	for i, planned := range plan.Actions {
+		names[i] = planned.Name
+	}
+	live, err := c.liveConnectors(ctx, names, true)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkDrift(plan, live); err != nil {
+		return nil, err
+	}
+
+	start := time.Now()
+	results = make([]DeployResult, len(plan.Actions))
+	started := make([]bool, len(plan.Actions))
+	lock := sync.Mutex{}
+
+	requests := make([]CreateConnectorRequest, len(plan.Actions))
+	for i, planned := range plan.Actions {
+		requests[i] = CreateConnectorRequest{ConnectorRequest: ConnectorRequest{Name: planned.Name}, Config: planned.Config,
+			DeployOptions: planned.DeployOptions}
+	}
+
+	err = c.runEach(ctx, requests, func(i int, req CreateConnectorRequest) error {
+		planned := plan.Actions[i]
+		result := DeployResult{Name: req.Name, Action: planned.Action, Diff: planned.Diff}
+		var err error
+		if planned.Action == DeployUnchanged {
+			status := live[req.Name].Status
+			result.ConnectorState, result.Tasks = status.ConnectorStatus["state"], status.TasksStatus
+			result.Duration = time.Since(start)
+		} else {
+			result, err = c.applyAction(ctx, req, planned.LiveConfig, result)
+		}
+		lock.Lock()
+		defer lock.Unlock()
+		results[i], started[i] = result, true
+		return err
+	})
+
+	for i, planned := range plan.Actions {
+		if !started[i] {
+			results[i] = DeployResult{Name: planned.Name, Err: ctx.Err()}
+		}
+	}
+	return results, err
+}
+
+// applyAction creates, updates or deletes a connector as planned, previous is its config when the plan was made
+func (c *highLevelClient) applyAction(ctx context.Context, req CreateConnectorRequest, previous map[string]interface{}, result DeployResult) (_ DeployResult, err error) {
+	ctx, op := c.startOperation(ctx, "DeployConnector", req.Name)
+	defer func() { op.end(err) }()
+
+	start := time.Now()
+	c.log().Info("deploying connector", "connector", req.Name, "action", result.Action, "diff", result.Diff.String())
+	if result.Action == DeployDeleted {
+		_, err = c.DeleteConnectorContext(ctx, req.ConnectorRequest, true)
+	} else {
+		err = c.updateConnector(ctx, req, previous, &result)
+		// unless the health check already fetched it
+		if err == nil && result.ConnectorState == "" {
+			c.setFinalState(ctx, &result)
+		}
+	}
+	result.Duration = time.Since(start)
+	result.Err = err
+	return result, err
+}
+
+// checkDrift compares the live state of connectors with the one they had when the plan was made
+func checkDrift(plan Plan, live map[string]ExpandedConnector) error {
+	drifted := []string{}
+	for _, planned := range plan.Actions {
+		existing, exists := live[planned.Name]
+		switch {
+		case exists != (planned.LiveConfig != nil):
+			drifted = append(drifted, planned.Name)
+		case exists && len(diffConfig(existing.Info.Config, planned.LiveConfig)) > 0:
+			drifted = append(drifted, planned.Name)
+		}
+	}
+	if len(drifted) > 0 {
+		sort.Strings(drifted)
+		return &PlanDriftError{Connectors: drifted}
+	}
+	return nil
+}
+
+// liveConnectors fetches the given connectors which exist, every one if names is nil, with their config and optionally their status
+// Connectors are fetched in a single call, or one by one without their status if expand is not supported
+func (c *highLevelClient) liveConnectors(ctx context.Context, names []string, expandStatus bool) (map[string]ExpandedConnector, error) {
+	deployed, err := c.GetAllExpandedContext(ctx, expandStatus, true)
+	if err == nil && deployed.Code < 400 {
+		return deployed.Connectors, nil
+	}
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+	if names == nil {
+		all, err := c.GetAllContext(ctx)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not list connectors")
+		}
+		names = all.Connectors
+	}
+
+	live := make(map[string]ExpandedConnector, len(names))
+	for _, name := range names {
+		existing, err := c.GetConnectorContext(ctx, ConnectorRequest{Name: name})
+		if isNotFound(existing.Code, err) {
+			continue
+		}
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not get connector %v", name)
+		}
+		live[name] = ExpandedConnector{Info: existing}
+	}
+	return live, nil
+}
@@ -0,0 +1,159 @@
+//go:build !integration
+
+package connectors
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func liveState() GetAllExpandedConnectorsResponse {
+	return GetAllExpandedConnectorsResponse{Connectors: map[string]ExpandedConnector{
+		"test1": {Info: ConnectorResponse{Name: "test1", Config: map[string]interface{}{"name": "test1", "param1": "2"}}},
+		"test2": {
+			Info:   ConnectorResponse{Name: "test2", Config: map[string]interface{}{"name": "test2", "param1": "2"}},
+			Status: GetConnectorStatusResponse{ConnectorStatus: map[string]string{"state": "RUNNING"}},
+		},
+	}}
+}
+
+var desiredState = []CreateConnectorRequest{
+	{ConnectorRequest: ConnectorRequest{Name: "test1"}, Config: map[string]interface{}{"param1": 3}},
+	{ConnectorRequest: ConnectorRequest{Name: "test2"}, Config: map[string]interface{}{"param1": 2}},
+	{ConnectorRequest: ConnectorRequest{Name: "test3"}, Config: map[string]interface{}{"param1": 2}},
+}
+
+func Test_Plan(t *testing.T) {
+	mockBaseClient := &MockBaseClient{}
+	mockBaseClient.On("GetAllExpandedContext", mock.Anything, false, true).Return(liveState(), nil)
+
+	client := &highLevelClient{client: mockBaseClient}
+	plan, err := client.Plan(desiredState)
+
+	assert.NoError(t, err)
+	if assert.Len(t, plan.Actions, 3) {
+		assert.Equal(t, DeployUpdated, plan.Actions[0].Action)
+		assert.Equal(t, ConfigDiff{{Key: "param1", Old: "2", New: 3}}, plan.Actions[0].Diff)
+		assert.Equal(t, DeployUnchanged, plan.Actions[1].Action)
+		assert.Empty(t, plan.Actions[1].Diff)
+		assert.Equal(t, DeployCreated, plan.Actions[2].Action)
+		assert.Equal(t, ConfigDiff{{Key: "param1", New: 2}}, plan.Actions[2].Diff)
+		assert.Nil(t, plan.Actions[2].LiveConfig)
+	}
+	assert.True(t, plan.HasChanges())
+	assert.Equal(t, 1, plan.Count(DeployCreated))
+	mockBaseClient.AssertNotCalled(t, "UpdateConnectorContext", mock.Anything, mock.Anything)
+}
+
+func Test_Plan_Without_Expand(t *testing.T) {
+	mockBaseClient := &MockBaseClient{}
+	mockBaseClient.On("GetAllExpandedContext", mock.Anything, false, true).
+		Return(GetAllExpandedConnectorsResponse{}, errors.New("expand not supported"))
+	mockBaseClient.On("GetConnectorContext", mock.Anything, ConnectorRequest{Name: "test1"}).
+		Return(ConnectorResponse{Name: "test1", Config: map[string]interface{}{"name": "test1", "param1": "3"}}, nil)
+	mockBaseClient.On("GetConnectorContext", mock.Anything, ConnectorRequest{Name: "test2"}).
+		Return(ConnectorResponse{EmptyResponse: EmptyResponse{Code: 404}}, nil)
+
+	client := &highLevelClient{client: mockBaseClient}
+	plan, err := client.Plan(desiredState[:2])
+
+	assert.NoError(t, err)
+	assert.Equal(t, DeployUnchanged, plan.Actions[0].Action)
+	assert.Equal(t, DeployCreated, plan.Actions[1].Action)
+	assert.True(t, plan.HasChanges())
+}
+
+func Test_Apply_Saved_Plan(t *testing.T) {
+	mockBaseClient := &MockBaseClient{}
+	mockBaseClient.On("GetAllExpandedContext", mock.Anything, false, true).Return(liveState(), nil)
+	mockBaseClient.On("GetAllExpandedContext", mock.Anything, true, true).Return(liveState(), nil)
+	mockBaseClient.On("UpdateConnectorContext", mock.Anything, mock.Anything).Return(ConnectorResponse{}, nil)
+	mockBaseClient.On("GetConnectorConfigContext", mock.Anything, ConnectorRequest{Name: "test1"}).
+		Return(GetConnectorConfigResponse{Config: map[string]interface{}{"name": "test1", "param1": "3"}}, nil)
+	mockBaseClient.On("GetConnectorConfigContext", mock.Anything, ConnectorRequest{Name: "test3"}).
+		Return(GetConnectorConfigResponse{Config: map[string]interface{}{"name": "test3", "param1": "2"}}, nil)
+	mockBaseClient.On("GetConnectorStatusContext", mock.Anything, mock.Anything).
+		Return(GetConnectorStatusResponse{ConnectorStatus: map[string]string{"state": "RUNNING"}}, nil)
+
+	client := &highLevelClient{client: mockBaseClient, maxParallelRequest: 2}
+	plan, err := client.Plan(desiredState)
+	assert.NoError(t, err)
+
+	// the plan is applied as read from a file
+	saved, err := json.Marshal(plan)
+	assert.NoError(t, err)
+	loaded := Plan{}
+	assert.NoError(t, json.Unmarshal(saved, &loaded))
+
+	results, err := client.Apply(loaded)
+
+	assert.NoError(t, err)
+	if assert.Len(t, results, 3) {
+		assert.Equal(t, DeployUpdated, results[0].Action)
+		assert.Equal(t, "RUNNING", results[0].ConnectorState)
+		assert.Equal(t, DeployUnchanged, results[1].Action)
+		assert.Equal(t, "RUNNING", results[1].ConnectorState)
+		assert.Equal(t, DeployCreated, results[2].Action)
+	}
+	mockBaseClient.AssertNumberOfCalls(t, "UpdateConnectorContext", 2)
+}
+
+func Test_Apply_Saved_Plan_With_Empty_Live_Config(t *testing.T) {
+	live := GetAllExpandedConnectorsResponse{Connectors: map[string]ExpandedConnector{
+		"test1": {Info: ConnectorResponse{Name: "test1", Config: map[string]interface{}{}}},
+	}}
+	mockBaseClient := &MockBaseClient{}
+	mockBaseClient.On("GetAllExpandedContext", mock.Anything, false, true).Return(live, nil)
+	mockBaseClient.On("GetAllExpandedContext", mock.Anything, true, true).Return(live, nil)
+	mockBaseClient.On("UpdateConnectorContext", mock.Anything, mock.Anything).Return(ConnectorResponse{}, nil)
+	mockBaseClient.On("GetConnectorConfigContext", mock.Anything, ConnectorRequest{Name: "test1"}).
+		Return(GetConnectorConfigResponse{Config: map[string]interface{}{"name": "test1", "param1": "3"}}, nil)
+	mockBaseClient.On("GetConnectorStatusContext", mock.Anything, mock.Anything).
+		Return(GetConnectorStatusResponse{ConnectorStatus: map[string]string{"state": "RUNNING"}}, nil)
+
+	client := &highLevelClient{client: mockBaseClient, maxParallelRequest: 2}
+	plan, err := client.Plan(desiredState[:1])
+	assert.NoError(t, err)
+
+	saved, err := json.Marshal(plan)
+	assert.NoError(t, err)
+	loaded := Plan{}
+	assert.NoError(t, json.Unmarshal(saved, &loaded))
+	// an empty config is not mistaken for a connector which did not exist
+	assert.Equal(t, map[string]interface{}{}, loaded.Actions[0].LiveConfig)
+
+	results, err := client.Apply(loaded)
+
+	assert.NoError(t, err)
+	if assert.Len(t, results, 1) {
+		assert.Equal(t, DeployUpdated, results[0].Action)
+	}
+}
+
+func Test_Apply_Drift(t *testing.T) {
+	drifted := liveState()
+	drifted.Connectors["test2"].Info.Config["param1"] = "5"
+	drifted.Connectors["test3"] = ExpandedConnector{Info: ConnectorResponse{Name: "test3", Config: map[string]interface{}{"name": "test3"}}}
+
+	mockBaseClient := &MockBaseClient{}
+	mockBaseClient.On("GetAllExpandedContext", mock.Anything, false, true).Return(liveState(), nil)
+	mockBaseClient.On("GetAllExpandedContext", mock.Anything, true, true).Return(drifted, nil)
+
+	client := &highLevelClient{client: mockBaseClient, maxParallelRequest: 2}
+	plan, err := client.Plan(desiredState)
+	assert.NoError(t, err)
+
+	results, err := client.Apply(plan)
+
+	assert.Nil(t, results)
+	assert.True(t, errors.Is(err, ErrPlanDrift))
+	driftErr := &PlanDriftError{}
+	if assert.True(t, errors.As(err, &driftErr)) {
+		assert.Equal(t, []string{"test2", "test3"}, driftErr.Connectors)
+	}
+	mockBaseClient.AssertNotCalled(t, "UpdateConnectorContext", mock.Anything, mock.Anything)
+}
@@ -0,0 +1,147 @@
+package connectors
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+//ErrWaitTimeout is returned when a synchronous operation did not complete in time
+//Use errors.As with *WaitTimeoutError to get the last observed state
+var ErrWaitTimeout = errors.New("timeout waiting for kafka-connect")
+
+//WaitTimeoutError is returned when a synchronous operation did not complete in time
+type WaitTimeoutError struct {
+	Operation string
+	Timeout   time.Duration
+	// LastState is the last response received while waiting, e.g. a GetConnectorStatusResponse
+	// It is nil if no response was received, values of secrets in connector configs are redacted
+	LastState interface{}
+	// LastErr is the error of the last check, if it failed
+	LastErr error
+}
+
+func (err *WaitTimeoutError) Error() string {
+	msg := fmt.Sprintf("timeout on %s sync after %v", err.Operation, err.Timeout)
+	if err.LastErr != nil {
+		msg = fmt.Sprintf("%s, last error: %v", msg, err.LastErr)
+	}
+	return msg
+}
+
+//Is makes errors.Is(err, ErrWaitTimeout) true
+func (err *WaitTimeoutError) Is(target error) bool {
+	return target == ErrWaitTimeout
+}
+
+//WaitOptions configures how synchronous operations wait for kafka-connect to apply a change
+//Zero fields fall back to the client settings, then to DefaultWaitOptions
+type WaitOptions struct {
+	// Timeout is the maximum time to wait
+	Timeout time.Duration
+	// Interval is the time to wait before the second check
+	Interval time.Duration
+	// MaxInterval caps the time between two checks
+	MaxInterval time.Duration
+	// Multiplier increases the interval after each check, 1 disables backoff
+	Multiplier float64
+	// Jitter randomizes each interval by up to this ratio, between 0 and 1, a negative value disables it
+	Jitter float64
+}
+
+//DefaultWaitOptions returns settings used when none are given
+func DefaultWaitOptions() WaitOptions {
+	return WaitOptions{
+		Timeout:     2 * time.Minute,
+		Interval:    500 * time.Millisecond,
+		MaxInterval: 5 * time.Second,
+		Multiplier:  1.5,
+		Jitter:      0.2,
+	}
+}
+
+// merge fills zero fields with the ones of fallback
+func (o WaitOptions) merge(fallback WaitOptions) WaitOptions {
+	if o.Timeout <= 0 {
+		o.Timeout = fallback.Timeout
+	}
+	if o.Interval <= 0 {
+		o.Interval = fallback.Interval
+	}
+	if o.MaxInterval <= 0 {
+		o.MaxInterval = fallback.MaxInterval
+	}
+	if o.Multiplier <= 0 {
+		o.Multiplier = fallback.Multiplier
+	}
+	if o.Jitter == 0 {
+		o.Jitter = fallback.Jitter
+	}
+	return o
+}
+
+type waitOptionsKey struct{}
+
+//ContextWithWaitOptions returns a context carrying wait settings for a single call
+//Settings are used by synchronous operations called with this context, instead of the client ones
+func ContextWithWaitOptions(ctx context.Context, options WaitOptions) context.Context {
+	return context.WithValue(ctx, waitOptionsKey{}, options)
+}
+
+func waitOptionsFromContext(ctx context.Context) (WaitOptions, bool) {
+	options, ok := ctx.Value(waitOptionsKey{}).(WaitOptions)
+	return options, ok
+}
+
+// check looks at the state of kafka-connect once, it returns what it observed and whether waiting is over
+type check func(ctx context.Context) (state interface{}, done bool, err error)
+
+// poll runs check until it is done, timeout elapses or ctx is cancelled
+// It runs in the caller goroutine, and requests made by check are cancelled as soon as it returns
+func (o WaitOptions) poll(ctx context.Context, operation string, exec check) error {
+	parent := ctx
+	ctx, cancel := context.WithTimeout(ctx, o.Timeout)
+	defer cancel()
+
+	var lastState interface{}
+	var lastErr error
+	interval := o.Interval
+	for {
+		state, done, err := exec(ctx)
+		if done {
+			return nil
+		}
+		if err == nil && state != nil {
+			lastState = redactState(state)
+		}
+		lastErr = err
+
+		timer := time.NewTimer(o.jitter(interval))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			if parent.Err() != nil {
+				return errors.Wrapf(parent.Err(), "%s sync interrupted", operation)
+			}
+			return &WaitTimeoutError{Operation: operation, Timeout: o.Timeout, LastState: lastState, LastErr: lastErr}
+		case <-timer.C:
+		}
+
+		interval = time.Duration(float64(interval) * o.Multiplier)
+		if interval > o.MaxInterval {
+			interval = o.MaxInterval
+		}
+	}
+}
+
+// jitter randomizes interval by up to o.Jitter in both directions, to spread checks of parallel operations
+func (o WaitOptions) jitter(interval time.Duration) time.Duration {
+	if o.Jitter <= 0 {
+		return interval
+	}
+	delta := o.Jitter * float64(interval)
+	return interval + time.Duration(delta*(2*rand.Float64()-1))
+}
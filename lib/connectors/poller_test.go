@@ -0,0 +1,84 @@
+//go:build !integration
+
+package connectors
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_poll_When_Success(t *testing.T) {
+	calls := 0
+	err := DefaultWaitOptions().poll(
+		context.Background(),
+		"test",
+		func(ctx context.Context) (interface{}, bool, error) {
+			calls++
+			return calls, calls == 3, nil
+		},
+	)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, calls)
+}
+
+func Test_poll_When_Timeout(t *testing.T) {
+	options := WaitOptions{Timeout: 100 * time.Millisecond, Interval: 10 * time.Millisecond, MaxInterval: 20 * time.Millisecond, Multiplier: 2}
+	calls := 0
+	err := options.poll(
+		context.Background(),
+		"test",
+		func(ctx context.Context) (interface{}, bool, error) {
+			calls++
+			if calls > 2 {
+				// a failed check keeps the last state received
+				return nil, false, errors.New("unreachable")
+			}
+			return calls, false, nil
+		},
+	)
+
+	var timeoutErr *WaitTimeoutError
+	assert.True(t, errors.As(err, &timeoutErr))
+	assert.True(t, errors.Is(err, ErrWaitTimeout))
+	assert.Equal(t, 2, timeoutErr.LastState)
+	assert.EqualError(t, timeoutErr.LastErr, "unreachable")
+	assert.Equal(t, "timeout on test sync after 100ms, last error: unreachable", err.Error())
+}
+
+func Test_poll_When_Cancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(50*time.Millisecond, cancel)
+
+	start := time.Now()
+	err := DefaultWaitOptions().poll(
+		ctx,
+		"test",
+		func(ctx context.Context) (interface{}, bool, error) {
+			return nil, false, nil
+		},
+	)
+	assert.True(t, errors.Is(err, context.Canceled))
+	assert.False(t, errors.Is(err, ErrWaitTimeout))
+	assert.True(t, time.Since(start) < time.Second)
+}
+
+func Test_WaitOptions_merge(t *testing.T) {
+	options := WaitOptions{Timeout: time.Second, Multiplier: 1}.merge(DefaultWaitOptions())
+
+	assert.Equal(t, time.Second, options.Timeout)
+	assert.Equal(t, 1.0, options.Multiplier)
+	assert.Equal(t, DefaultWaitOptions().Interval, options.Interval)
+	assert.Equal(t, DefaultWaitOptions().MaxInterval, options.MaxInterval)
+}
+
+func Test_WaitOptions_jitter(t *testing.T) {
+	options := WaitOptions{Jitter: 0.5}
+	for i := 0; i < 100; i++ {
+		interval := options.jitter(time.Second)
+		assert.True(t, interval >= 500*time.Millisecond && interval <= 1500*time.Millisecond)
+	}
+}
@@ -0,0 +1,113 @@
+package connectors
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+//OwnershipSelector selects the connectors managed by a reconciliation, the only ones it may delete
+//When both a prefix and a marker are set, connectors must match both
+type OwnershipSelector struct {
+	// NamePrefix selects connectors which name starts with it
+	NamePrefix string
+	// MarkerKey selects connectors which config has this key, e.g. "owner"
+	MarkerKey string
+	// MarkerValue is the value MarkerKey must have, any value is accepted if empty
+	MarkerValue string
+}
+
+//Owns tells whether a connector is selected
+func (s OwnershipSelector) Owns(name string, config map[string]interface{}) bool {
+	if s.NamePrefix == "" && s.MarkerKey == "" {
+		return false
+	}
+	if !strings.HasPrefix(name, s.NamePrefix) {
+		return false
+	}
+	if s.MarkerKey != "" {
+		value, ok := config[s.MarkerKey]
+		if !ok || (s.MarkerValue != "" && convertConfigValueToString(value) != s.MarkerValue) {
+			return false
+		}
+	}
+	return true
+}
+
+//ReconcileRequest is the full desired state of the connectors selected by Owner
+type ReconcileRequest struct {
+	Connectors []CreateConnectorRequest
+	// Owner selects the live connectors managed by this request, those missing from Connectors are deleted
+	// It must not be empty, and every connector of Connectors must match it
+	Owner OwnershipSelector
+}
+
+//PlanReconcile is Plan for a full desired state: live connectors selected by req.Owner which are not in req.Connectors
+//are planned for deletion. The plan can be executed with Apply
+func (c *highLevelClient) PlanReconcile(req ReconcileRequest) (Plan, error) {
+	return c.PlanReconcileContext(context.Background(), req)
+}
+
+//PlanReconcileContext is PlanReconcile with a context, which is used to cancel requests
+func (c *highLevelClient) PlanReconcileContext(ctx context.Context, req ReconcileRequest) (plan Plan, err error) {
+	ctx, op := c.startOperation(ctx, "PlanReconcile", "")
+	defer func() { op.end(err) }()
+
+	if req.Owner.NamePrefix == "" && req.Owner.MarkerKey == "" {
+		return Plan{}, errors.New("an ownership selector is required to reconcile, every connector would be deleted otherwise")
+	}
+	desired := make(map[string]bool, len(req.Connectors))
+	for _, connector := range req.Connectors {
+		// it could never be pruned once removed from the desired state
+		if !req.Owner.Owns(connector.Name, connector.Config) {
+			return Plan{}, errors.Errorf("connector %v does not match the ownership selector", connector.Name)
+		}
+		desired[connector.Name] = true
+	}
+
+	// every connector is needed to find those to delete
+	live, err := c.liveConnectors(ctx, nil, false)
+	if err != nil {
+		return Plan{}, err
+	}
+	plan = planActions(req.Connectors, live)
+
+	pruned := []string{}
+	for name, existing := range live {
+		if !desired[name] && req.Owner.Owns(name, existing.Info.Config) {
+			pruned = append(pruned, name)
+		}
+	}
+	sort.Strings(pruned)
+	for _, name := range pruned {
+		config := live[name].Info.Config
+		plan.Actions = append(plan.Actions, PlannedAction{
+			Name:       name,
+			Action:     DeployDeleted,
+			Diff:       diffConfig(nil, config),
+			LiveConfig: config,
+		})
+	}
+	return plan, nil
+}
+
+//Reconcile makes the connectors selected by req.Owner match req.Connectors: they are deployed like DeployMultipleConnector
+//does, and live connectors selected by req.Owner which are missing from req.Connectors are deleted
+//Results are in the order of req.Connectors, followed by deleted connectors sorted by name
+func (c *highLevelClient) Reconcile(req ReconcileRequest) ([]DeployResult, error) {
+	return c.ReconcileContext(context.Background(), req)
+}
+
+//ReconcileContext is Reconcile with a context, which is used to cancel requests and synchronous waits
+func (c *highLevelClient) ReconcileContext(ctx context.Context, req ReconcileRequest) (results []DeployResult, err error) {
+	ctx, op := c.startOperation(ctx, "Reconcile", "")
+	defer func() { op.end(err) }()
+
+	plan, err := c.PlanReconcileContext(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return c.ApplyContext(ctx, plan)
+}
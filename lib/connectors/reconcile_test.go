@@ -0,0 +1,96 @@
+//go:build !integration
+
+package connectors
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_OwnershipSelector_Owns(t *testing.T) {
+	config := map[string]interface{}{"owner": "team-a"}
+
+	assert.False(t, OwnershipSelector{}.Owns("team-a-sink", config))
+	assert.True(t, OwnershipSelector{NamePrefix: "team-a-"}.Owns("team-a-sink", nil))
+	assert.False(t, OwnershipSelector{NamePrefix: "team-a-"}.Owns("team-b-sink", config))
+	assert.True(t, OwnershipSelector{MarkerKey: "owner"}.Owns("sink", config))
+	assert.True(t, OwnershipSelector{MarkerKey: "owner", MarkerValue: "team-a"}.Owns("sink", config))
+	assert.False(t, OwnershipSelector{MarkerKey: "owner", MarkerValue: "team-b"}.Owns("sink", config))
+	assert.False(t, OwnershipSelector{MarkerKey: "owner"}.Owns("sink", nil))
+	assert.False(t, OwnershipSelector{NamePrefix: "team-a-", MarkerKey: "owner"}.Owns("team-a-sink", nil))
+}
+
+func reconcileLiveState() GetAllExpandedConnectorsResponse {
+	return GetAllExpandedConnectorsResponse{Connectors: map[string]ExpandedConnector{
+		"a-kept":    {Info: ConnectorResponse{Name: "a-kept", Config: map[string]interface{}{"name": "a-kept", "param1": "2"}}},
+		"a-removed": {Info: ConnectorResponse{Name: "a-removed", Config: map[string]interface{}{"name": "a-removed", "param1": "2"}}},
+		"b-other":   {Info: ConnectorResponse{Name: "b-other", Config: map[string]interface{}{"name": "b-other", "param1": "2"}}},
+	}}
+}
+
+func Test_PlanReconcile(t *testing.T) {
+	mockBaseClient := &MockBaseClient{}
+	mockBaseClient.On("GetAllExpandedContext", mock.Anything, false, true).Return(reconcileLiveState(), nil)
+
+	client := &highLevelClient{client: mockBaseClient}
+	plan, err := client.PlanReconcile(ReconcileRequest{
+		Connectors: []CreateConnectorRequest{
+			{ConnectorRequest: ConnectorRequest{Name: "a-kept"}, Config: map[string]interface{}{"param1": 2}},
+		},
+		Owner: OwnershipSelector{NamePrefix: "a-"},
+	})
+
+	assert.NoError(t, err)
+	if assert.Len(t, plan.Actions, 2) {
+		assert.Equal(t, DeployUnchanged, plan.Actions[0].Action)
+		assert.Equal(t, "a-removed", plan.Actions[1].Name)
+		assert.Equal(t, DeployDeleted, plan.Actions[1].Action)
+		assert.Equal(t, ConfigDiff{{Key: "param1", Old: "2"}}, plan.Actions[1].Diff)
+	}
+}
+
+func Test_PlanReconcile_Without_Selector(t *testing.T) {
+	client := &highLevelClient{client: &MockBaseClient{}}
+	_, err := client.PlanReconcile(ReconcileRequest{})
+
+	assert.Error(t, err)
+}
+
+func Test_PlanReconcile_Desired_Not_Owned(t *testing.T) {
+	client := &highLevelClient{client: &MockBaseClient{}}
+	_, err := client.PlanReconcile(ReconcileRequest{
+		Connectors: []CreateConnectorRequest{{ConnectorRequest: ConnectorRequest{Name: "b-new"}}},
+		Owner:      OwnershipSelector{NamePrefix: "a-"},
+	})
+
+	assert.Error(t, err)
+}
+
+func Test_Reconcile(t *testing.T) {
+	mockBaseClient := &MockBaseClient{}
+	mockBaseClient.On("GetAllExpandedContext", mock.Anything, false, true).Return(reconcileLiveState(), nil)
+	mockBaseClient.On("GetAllExpandedContext", mock.Anything, true, true).Return(reconcileLiveState(), nil)
+	mockBaseClient.On("DeleteConnectorContext", mock.Anything, ConnectorRequest{Name: "a-removed"}).Return(EmptyResponse{}, nil)
+	mockBaseClient.On("GetConnectorContext", mock.Anything, ConnectorRequest{Name: "a-removed"}).
+		Return(ConnectorResponse{EmptyResponse: EmptyResponse{Code: 404}}, nil)
+
+	client := &highLevelClient{client: mockBaseClient, maxParallelRequest: 2}
+	results, err := client.Reconcile(ReconcileRequest{
+		Connectors: []CreateConnectorRequest{
+			{ConnectorRequest: ConnectorRequest{Name: "a-kept"}, Config: map[string]interface{}{"param1": 2}},
+		},
+		Owner: OwnershipSelector{NamePrefix: "a-"},
+	})
+
+	assert.NoError(t, err)
+	if assert.Len(t, results, 2) {
+		assert.Equal(t, DeployUnchanged, results[0].Action)
+		assert.Equal(t, "a-removed", results[1].Name)
+		assert.Equal(t, DeployDeleted, results[1].Action)
+		assert.NoError(t, results[1].Err)
+	}
+	mockBaseClient.AssertCalled(t, "DeleteConnectorContext", mock.Anything, ConnectorRequest{Name: "a-removed"})
+	mockBaseClient.AssertNotCalled(t, "DeleteConnectorContext", mock.Anything, ConnectorRequest{Name: "b-other"})
+}
@@ -0,0 +1,195 @@
+package connectors
+
+import (
+	"math"
+	"math/rand"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"gopkg.in/resty.v1"
+)
+
+//RetryAttempt describes a request that was just sent, for a RetryPolicy to decide if it is sent again
+type RetryAttempt struct {
+	// Attempt is the number of times the request was sent, starting at 1
+	Attempt int
+	Method  string
+	URL     string
+	// Response is nil if none was received, its body was already read, see Body
+	Response *http.Response
+	Body     []byte
+	// Err is the error of the http client, e.g. a connection reset
+	Err error
+}
+
+//StatusCode returns the status of the response, 0 if none was received
+func (a RetryAttempt) StatusCode() int {
+	if a.Response == nil {
+		return 0
+	}
+	return a.Response.StatusCode
+}
+
+//RetryPolicy decides whether a request is sent again, it is called after every attempt
+type RetryPolicy interface {
+	// ShouldRetry returns whether to send the request again, and how long to wait before
+	ShouldRetry(attempt RetryAttempt) (bool, time.Duration)
+}
+
+//RetryPolicyFunc is an adapter to use a function as a RetryPolicy
+type RetryPolicyFunc func(attempt RetryAttempt) (bool, time.Duration)
+
+//ShouldRetry calls f(attempt)
+func (f RetryPolicyFunc) ShouldRetry(attempt RetryAttempt) (bool, time.Duration) {
+	return f(attempt)
+}
+
+//DefaultRetryPolicy retries requests which failed because kafka-connect was busy or unreachable
+//Requests which may have been handled already are only retried if they are idempotent, so a POST is retried
+//only when it is known it did not reach kafka-connect or was rejected: connection refused, 503, or 409 during a rebalance
+type DefaultRetryPolicy struct {
+	// MaxRetries is the number of retries after the first attempt
+	MaxRetries int
+	// WaitTime is the minimum wait between two attempts, it grows exponentially up to MaxWaitTime
+	WaitTime    time.Duration
+	MaxWaitTime time.Duration
+}
+
+//ShouldRetry implements RetryPolicy
+func (p DefaultRetryPolicy) ShouldRetry(attempt RetryAttempt) (bool, time.Duration) {
+	if attempt.Attempt > p.MaxRetries || !p.isRetryable(attempt) {
+		return false, 0
+	}
+	return true, p.backoff(attempt.Attempt)
+}
+
+func (p DefaultRetryPolicy) isRetryable(attempt RetryAttempt) bool {
+	if attempt.Err != nil {
+		// nothing was sent if the connection could not be opened
+		if isDialError(attempt.Err) {
+			return true
+		}
+		return isIdempotent(attempt.Method)
+	}
+
+	switch status := attempt.StatusCode(); {
+	case status == 409:
+		// kafka-connect rejects requests during a rebalance, but also the creation of an existing connector
+		return !strings.Contains(string(attempt.Body), "already exists")
+	case status == 503:
+		return true
+	case status == 500:
+		// kafka-connect times out while the leader is busy, but the request may still be handled
+		return isIdempotent(attempt.Method) && strings.Contains(string(attempt.Body), "Request timed out")
+	case status == 502, status == 504:
+		return isIdempotent(attempt.Method)
+	default:
+		return false
+	}
+}
+
+// backoff returns a capped exponential wait with jitter, never shorter than WaitTime
+func (p DefaultRetryPolicy) backoff(attempt int) time.Duration {
+	wait := math.Min(float64(p.MaxWaitTime), float64(p.WaitTime)*math.Exp2(float64(attempt-1)))
+	wait = wait/2 + rand.Float64()*wait/2
+	if wait < float64(p.WaitTime) {
+		return p.WaitTime
+	}
+	return time.Duration(wait)
+}
+
+func isIdempotent(method string) bool {
+	switch method {
+	case resty.MethodGet, resty.MethodHead, resty.MethodOptions, resty.MethodPut, resty.MethodDelete:
+		return true
+	default:
+		return false
+	}
+}
+
+// requestLogFields describes an attempt to send a request, secrets in headers are redacted
+func requestLogFields(current *call, method string, request *resty.Request, resp *resty.Response, err error) []interface{} {
+	var fields []interface{}
+	if current != nil {
+		fields = append(fields, "operation", current.operation)
+		if current.connector != "" {
+			fields = append(fields, "connector", current.connector)
+		}
+		if current.info != nil {
+			fields = append(fields, "attempt", current.info.Attempt)
+		}
+	}
+	fields = append(fields, "method", method, "url", request.URL)
+	if request.RawRequest != nil {
+		fields = append(fields, "headers", redactHeaders(request.RawRequest.Header))
+	}
+	if resp != nil && resp.RawResponse != nil {
+		fields = append(fields, "status", resp.StatusCode())
+	}
+	if err != nil {
+		fields = append(fields, "error", err)
+	}
+	return fields
+}
+
+// execute sends request, and sends it again as long as the retry policy tells so
+// Waiting between attempts stops as soon as the request context is done
+func (c *baseClient) execute(request *resty.Request, method string, url string) (*resty.Response, error) {
+	for attempt := 1; ; attempt++ {
+		// token is set on every attempt, it may have been refreshed in between
+		if c.tokenSource != nil {
+			token, err := c.tokenSource.Token(request.Context())
+			if err != nil {
+				return nil, errors.Wrap(err, "could not authenticate")
+			}
+			request.SetAuthToken(token)
+		}
+
+		current := callFromContext(request.Context())
+		if current != nil {
+			current.info = &RequestInfo{Operation: current.operation, Connector: current.connector, Endpoint: url, Attempt: attempt}
+		}
+		release, err := c.limiter.acquire(request.Context())
+		if err != nil {
+			return nil, err
+		}
+		start := time.Now()
+		resp, err := request.Execute(method, url)
+		latency := time.Since(start)
+		release()
+		c.afterResponse(current, resp, err, latency)
+		fields := requestLogFields(current, method, request, resp, err)
+		c.log().Debug("request sent", append(fields, "latency", latency)...)
+		// a cancelled request, or one refused by an interceptor, is never retried
+		if request.Context().Err() != nil {
+			return resp, err
+		}
+		if current != nil && current.interceptorErr != nil {
+			return nil, current.interceptorErr
+		}
+
+		retryAttempt := RetryAttempt{Attempt: attempt, Method: method, URL: url, Err: err}
+		if resp != nil && resp.RawResponse != nil {
+			retryAttempt.Response = resp.RawResponse
+			retryAttempt.Body = resp.Body()
+		}
+		retry, wait := c.retryPolicy.ShouldRetry(retryAttempt)
+		if !retry {
+			if err != nil {
+				c.log().Warn("request failed", fields...)
+			}
+			return resp, err
+		}
+		c.log().Warn("retrying request", append(fields, "wait", wait)...)
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-request.Context().Done():
+			timer.Stop()
+			return resp, request.Context().Err()
+		case <-timer.C:
+		}
+	}
+}
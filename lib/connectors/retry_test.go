@@ -0,0 +1,113 @@
+//go:build !integration
+
+package connectors
+
+import (
+	"net"
+	"net/http"
+	"net/url"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/jarcoal/httpmock.v1"
+)
+
+func Test_DefaultRetryPolicy(t *testing.T) {
+	policy := DefaultRetryPolicy{MaxRetries: 2, WaitTime: 10 * time.Millisecond, MaxWaitTime: 20 * time.Millisecond}
+	refused := &url.Error{Op: "Post", URL: "http://connect", Err: &net.OpError{Op: "dial", Err: syscall.ECONNREFUSED}}
+	reset := &url.Error{Op: "Post", URL: "http://connect", Err: &net.OpError{Op: "read", Err: syscall.ECONNRESET}}
+	response := func(status int) *http.Response { return &http.Response{StatusCode: status} }
+
+	tests := []struct {
+		name     string
+		attempt  RetryAttempt
+		expected bool
+	}{
+		{"success", RetryAttempt{Attempt: 1, Method: "GET", Response: response(200)}, false},
+		{"not found", RetryAttempt{Attempt: 1, Method: "GET", Response: response(404)}, false},
+		{"rebalance", RetryAttempt{Attempt: 1, Method: "POST", Response: response(409), Body: []byte(`{"message":"Cannot complete request because of a conflicting operation (e.g. worker rebalance)"}`)}, true},
+		{"already exists", RetryAttempt{Attempt: 1, Method: "POST", Response: response(409), Body: []byte(`{"message":"Connector test already exists"}`)}, false},
+		{"unavailable", RetryAttempt{Attempt: 1, Method: "POST", Response: response(503)}, true},
+		{"timed out put", RetryAttempt{Attempt: 1, Method: "PUT", Response: response(500), Body: []byte(`{"message":"Request timed out"}`)}, true},
+		{"timed out post", RetryAttempt{Attempt: 1, Method: "POST", Response: response(500), Body: []byte(`{"message":"Request timed out"}`)}, false},
+		{"server error", RetryAttempt{Attempt: 1, Method: "GET", Response: response(500), Body: []byte(`{"message":"NullPointerException"}`)}, false},
+		{"connection refused post", RetryAttempt{Attempt: 1, Method: "POST", Err: refused}, true},
+		{"connection reset post", RetryAttempt{Attempt: 1, Method: "POST", Err: reset}, false},
+		{"connection reset get", RetryAttempt{Attempt: 1, Method: "GET", Err: reset}, true},
+		{"too many attempts", RetryAttempt{Attempt: 3, Method: "GET", Err: reset}, false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			retry, wait := policy.ShouldRetry(test.attempt)
+			assert.Equal(t, test.expected, retry)
+			if retry {
+				assert.True(t, wait >= policy.WaitTime && wait <= policy.MaxWaitTime)
+			}
+		})
+	}
+}
+
+func Test_CreateConnector_Not_Retried_When_Timed_Out(t *testing.T) {
+	typedClient, err := newBaseClientWithOptions("http://randomurl", clientOptions{
+		retryPolicy: DefaultRetryPolicy{MaxRetries: 3, WaitTime: time.Millisecond, MaxWaitTime: time.Millisecond},
+	})
+	assert.NoError(t, err)
+	// mock HTTP response
+	calls := 0
+	{
+		httpmock.Reset()
+		httpmock.ActivateNonDefault(typedClient.restClient.GetClient())
+		defer httpmock.DeactivateAndReset()
+
+		httpmock.RegisterResponder("POST", "http://randomurl/connectors", func(req *http.Request) (*http.Response, error) {
+			calls++
+			return httpmock.NewJsonResponse(500, ErrorResponse{ErrorCode: 500, Message: "Request timed out"})
+		})
+	}
+
+	//Act
+	_, err = typedClient.CreateConnector(CreateConnectorRequest{ConnectorRequest: ConnectorRequest{Name: "test"}})
+
+	assert.True(t, errors.Is(err, ErrServer))
+	assert.Equal(t, 1, calls)
+}
+
+func Test_UpdateConnector_Retried_When_Unavailable(t *testing.T) {
+	var attempts []RetryAttempt
+	typedClient, err := newBaseClientWithOptions("http://randomurl", clientOptions{
+		retryPolicy: RetryPolicyFunc(func(attempt RetryAttempt) (bool, time.Duration) {
+			attempts = append(attempts, attempt)
+			return attempt.StatusCode() == 503, time.Millisecond
+		}),
+	})
+	assert.NoError(t, err)
+	// mock HTTP response
+	{
+		httpmock.Reset()
+		httpmock.ActivateNonDefault(typedClient.restClient.GetClient())
+		defer httpmock.DeactivateAndReset()
+
+		calls := 0
+		httpmock.RegisterResponder("PUT", "http://randomurl/connectors/test/config", func(req *http.Request) (*http.Response, error) {
+			calls++
+			if calls == 1 {
+				return httpmock.NewStringResponse(503, "Service Unavailable"), nil
+			}
+			return httpmock.NewJsonResponse(200, ConnectorResponse{Name: "test"})
+		})
+	}
+
+	//Act
+	resp, err := typedClient.UpdateConnector(CreateConnectorRequest{ConnectorRequest: ConnectorRequest{Name: "test"}})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "test", resp.Name)
+	if assert.Len(t, attempts, 2) {
+		assert.Equal(t, "PUT", attempts[0].Method)
+		assert.Equal(t, []byte("Service Unavailable"), attempts[0].Body)
+		assert.Equal(t, 2, attempts[1].Attempt)
+	}
+}
@@ -0,0 +1,186 @@
+package connectors
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+//DeployStrategy tells how deploys update a connector which already exists
+type DeployStrategy string
+
+const (
+	// StrategyInPlace updates the config of the connector while it runs, it is the default
+	StrategyInPlace DeployStrategy = "in-place"
+	// StrategyPauseUpdateResume pauses the connector, updates its config, then resumes it
+	StrategyPauseUpdateResume DeployStrategy = "pause-update-resume"
+	// StrategyRecreate deletes the connector, then creates it again with the new config
+	// Recreating a paused or stopped connector requires kafka-connect 3.7 or later, unless DeployOptions.ResumePaused is set
+	// If the new config cannot be created, the connector is created again with its previous config. Should that fail too,
+	// e.g. when kafka-connect is unreachable, the connector is left deleted: nothing runs until it is deployed again
+	StrategyRecreate DeployStrategy = "recreate"
+)
+
+//DeployOptions configures how deploys update connectors which already exist
+//Connectors which were paused or stopped before a deploy stay so, whatever the strategy, unless ResumePaused is set
+type DeployOptions struct {
+	// Strategy is StrategyInPlace if empty
+	Strategy DeployStrategy `json:"strategy,omitempty"`
+	// ResumePaused resumes connectors which were paused or stopped before the deploy
+	ResumePaused bool `json:"resume_paused,omitempty"`
+}
+
+func (o DeployOptions) validate() error {
+	switch o.Strategy {
+	case "", StrategyInPlace, StrategyPauseUpdateResume, StrategyRecreate:
+		return nil
+	default:
+		return errors.Errorf("unknown deploy strategy: %v", o.Strategy)
+	}
+}
+
+//WithDeployOptions sets how deploys update connectors which already exist, see SetDeployOptions
+func WithDeployOptions(options DeployOptions) Option {
+	return func(o *clientOptions) error {
+		if err := options.validate(); err != nil {
+			return err
+		}
+		o.deployOptions = options
+		return nil
+	}
+}
+
+//SetDeployOptions sets how DeployConnector, DeployMultipleConnector, Apply and Reconcile update connectors which
+//already exist, StrategyInPlace by default. CreateConnectorRequest.DeployOptions overrides them for a single connector
+func (c *highLevelClient) SetDeployOptions(options DeployOptions) {
+	c.deployOptions = options
+}
+
+// deployOptionsFor returns the options of req if any, else the client ones
+func (c *highLevelClient) deployOptionsFor(req CreateConnectorRequest) DeployOptions {
+	options := c.deployOptions
+	if req.DeployOptions != nil {
+		options = *req.DeployOptions
+	}
+	if options.Strategy == "" {
+		options.Strategy = StrategyInPlace
+	}
+	return options
+}
+
+// deployConfig creates a connector, or updates an existing one with the deploy strategy of req
+// previous is the deployed config, nil if the connector does not exist
+func (c *highLevelClient) deployConfig(ctx context.Context, req CreateConnectorRequest, previous map[string]interface{}) error {
+	if previous == nil {
+		_, err := c.UpdateConnectorContext(ctx, req, true)
+		return err
+	}
+
+	options := c.deployOptionsFor(req)
+	if err := options.validate(); err != nil {
+		return err
+	}
+
+	// the state is only needed to leave paused connectors as they were, or to resume them
+	state := ""
+	if options.Strategy != StrategyInPlace || options.ResumePaused {
+		status, err := c.GetConnectorStatusContext(ctx, req.ConnectorRequest)
+		if err != nil {
+			return errors.Wrap(err, "could not get connector state before deploying")
+		}
+		state = status.ConnectorStatus["state"]
+	}
+	halted := state == StatePaused || state == StateStopped
+	c.log().Debug("deploy strategy", "connector", req.Name, "strategy", options.Strategy, "state", state, "resumePaused", options.ResumePaused)
+
+	switch options.Strategy {
+	case StrategyPauseUpdateResume:
+		return c.pauseUpdateResume(ctx, req, halted, options.ResumePaused)
+
+	case StrategyRecreate:
+		return c.recreate(ctx, req, previous, state, halted && !options.ResumePaused)
+
+	default:
+		if _, err := c.UpdateConnectorContext(ctx, req, true); err != nil {
+			return err
+		}
+		// kafka-connect keeps the state of a connector when its config is updated
+		if halted && options.ResumePaused {
+			_, err := c.ResumeConnectorContext(ctx, req.ConnectorRequest, true)
+			return err
+		}
+		return nil
+	}
+}
+
+// recreate deletes a connector, then creates it with the config of req, keepState tells whether it is created in state
+// The previous config is created again if the new one cannot be
+func (c *highLevelClient) recreate(ctx context.Context, req CreateConnectorRequest, previous map[string]interface{}, state string, keepState bool) error {
+	create := req
+	if keepState {
+		// the connector is created in the state it had, so that it never runs in between
+		// it is not deleted if the server cannot do so
+		if err := c.requireVersion(ctx, "recreate paused or stopped connector", minVersionInitialState); err != nil {
+			return err
+		}
+		create.InitialState = state
+	}
+	if _, err := c.DeleteConnectorContext(ctx, req.ConnectorRequest, true); err != nil {
+		return err
+	}
+	_, err := c.CreateConnectorContext(ctx, create, true)
+	if err == nil {
+		return nil
+	}
+
+	// ctx may be the reason the create failed
+	restore := CreateConnectorRequest{ConnectorRequest: req.ConnectorRequest, Config: previous, InitialState: create.InitialState}
+	if _, restoreErr := c.CreateConnectorContext(detachedContext{ctx}, restore, true); restoreErr != nil {
+		return errors.Wrapf(err, "connector %v may be left deleted, could not create it again with its previous config: %v", req.Name, restoreErr)
+	}
+	return err
+}
+
+// pauseUpdateResume updates a connector while it is paused, halted tells whether it was paused or stopped before
+// A connector paused by the deploy is resumed even if the deploy fails or is interrupted
+func (c *highLevelClient) pauseUpdateResume(ctx context.Context, req CreateConnectorRequest, halted bool, resumePaused bool) (err error) {
+	if !halted {
+		defer func() {
+			if err == nil {
+				return
+			}
+			// ctx may be the reason the deploy failed
+			if _, resumeErr := c.ResumeConnectorContext(detachedContext{ctx}, req.ConnectorRequest, true); resumeErr != nil {
+				err = errors.Wrapf(err, "connector %v may be left paused, could not resume it: %v", req.Name, resumeErr)
+			}
+		}()
+		if _, err = c.PauseConnectorContext(ctx, req.ConnectorRequest, true); err != nil {
+			return err
+		}
+	}
+	if _, err = c.UpdateConnectorContext(ctx, req, true); err != nil {
+		return err
+	}
+	if !halted || resumePaused {
+		_, err = c.ResumeConnectorContext(ctx, req.ConnectorRequest, true)
+	}
+	return err
+}
+
+// detachedContext keeps the values of a context, but neither its deadline nor its cancellation
+type detachedContext struct {
+	context.Context
+}
+
+func (detachedContext) Deadline() (time.Time, bool) {
+	return time.Time{}, false
+}
+
+func (detachedContext) Done() <-chan struct{} {
+	return nil
+}
+
+func (detachedContext) Err() error {
+	return nil
+}
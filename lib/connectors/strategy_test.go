@@ -0,0 +1,268 @@
+//go:build !integration
+
+package connectors
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func connectorInState(state string) GetConnectorStatusResponse {
+	return GetConnectorStatusResponse{EmptyResponse: EmptyResponse{Code: 200}, ConnectorStatus: map[string]string{"state": state}}
+}
+
+// mockExistingConnector mocks a connector which config param1 goes from 2 to 3 once updated
+func mockExistingConnector() *MockBaseClient {
+	mockBaseClient := &MockBaseClient{}
+	mockBaseClient.On("GetConnectorContext", mock.Anything, mock.Anything).
+		Return(ConnectorResponse{Name: "test1", Config: map[string]interface{}{"name": "test1", "param1": "2"}}, nil).Once()
+	mockBaseClient.On("GetConnectorConfigContext", mock.Anything, mock.Anything).
+		Return(GetConnectorConfigResponse{Config: map[string]interface{}{"name": "test1", "param1": "2"}}, nil).Once()
+	mockBaseClient.On("UpdateConnectorContext", mock.Anything, mock.Anything).Return(ConnectorResponse{}, nil)
+	mockBaseClient.On("GetConnectorConfigContext", mock.Anything, mock.Anything).
+		Return(GetConnectorConfigResponse{Config: map[string]interface{}{"name": "test1", "param1": "3"}}, nil)
+	return mockBaseClient
+}
+
+func Test_WithDeployOptions_Invalid(t *testing.T) {
+	_, err := NewClientWithOptions("http://localhost:8083", WithDeployOptions(DeployOptions{Strategy: "blue-green"}))
+
+	assert.Error(t, err)
+}
+
+func Test_DeployConnector_Pause_Update_Resume(t *testing.T) {
+	mockBaseClient := mockExistingConnector()
+	// before deploying, once paused, before resuming, once resumed
+	mockBaseClient.On("GetConnectorStatusContext", mock.Anything, mock.Anything).Return(connectorInState(StateRunning), nil).Once()
+	mockBaseClient.On("PauseConnectorContext", mock.Anything, mock.Anything).Return(EmptyResponse{}, nil)
+	mockBaseClient.On("GetConnectorStatusContext", mock.Anything, mock.Anything).Return(connectorInState(StatePaused), nil).Twice()
+	mockBaseClient.On("ResumeConnectorContext", mock.Anything, mock.Anything).Return(EmptyResponse{}, nil)
+	mockBaseClient.On("GetConnectorStatusContext", mock.Anything, mock.Anything).Return(connectorInState(StateRunning), nil).Once()
+
+	client := &highLevelClient{client: mockBaseClient}
+	err := client.DeployConnector(CreateConnectorRequest{
+		ConnectorRequest: ConnectorRequest{"test1"},
+		Config:           map[string]interface{}{"param1": 3},
+		DeployOptions:    &DeployOptions{Strategy: StrategyPauseUpdateResume},
+	})
+
+	assert.NoError(t, err)
+	mockBaseClient.AssertExpectations(t)
+}
+
+func Test_DeployConnector_Pause_Update_Resume_Update_Failed(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	mockBaseClient := &MockBaseClient{}
+	mockBaseClient.On("GetConnectorContext", mock.Anything, mock.Anything).
+		Return(ConnectorResponse{Name: "test1", Config: map[string]interface{}{"name": "test1", "param1": "2"}}, nil)
+	mockBaseClient.On("GetConnectorConfigContext", mock.Anything, mock.Anything).
+		Return(GetConnectorConfigResponse{Config: map[string]interface{}{"name": "test1", "param1": "2"}}, nil)
+	// before deploying, once paused, before resuming, once resumed
+	mockBaseClient.On("GetConnectorStatusContext", mock.Anything, mock.Anything).Return(connectorInState(StateRunning), nil).Once()
+	mockBaseClient.On("PauseConnectorContext", mock.Anything, mock.Anything).Return(EmptyResponse{}, nil)
+	mockBaseClient.On("GetConnectorStatusContext", mock.Anything, mock.Anything).Return(connectorInState(StatePaused), nil).Twice()
+	// the deploy times out while updating
+	mockBaseClient.On("UpdateConnectorContext", mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) { cancel() }).
+		Return(ConnectorResponse{}, context.Canceled)
+	mockBaseClient.On("ResumeConnectorContext", mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) { assert.NoError(t, args.Get(0).(context.Context).Err()) }).
+		Return(EmptyResponse{}, nil)
+	mockBaseClient.On("GetConnectorStatusContext", mock.Anything, mock.Anything).Return(connectorInState(StateRunning), nil).Once()
+
+	client := &highLevelClient{client: mockBaseClient}
+	client.SetDeployOptions(DeployOptions{Strategy: StrategyPauseUpdateResume})
+	err := client.DeployConnectorContext(ctx, CreateConnectorRequest{
+		ConnectorRequest: ConnectorRequest{"test1"},
+		Config:           map[string]interface{}{"param1": 3},
+	})
+
+	assert.Error(t, err)
+	assert.Equal(t, context.Canceled, errors.Cause(err))
+	// the connector is not left paused
+	mockBaseClient.AssertNumberOfCalls(t, "ResumeConnectorContext", 1)
+	mockBaseClient.AssertExpectations(t)
+}
+
+func Test_DeployConnector_Pause_Update_Resume_Stays_Paused(t *testing.T) {
+	mockBaseClient := mockExistingConnector()
+	mockBaseClient.On("GetConnectorStatusContext", mock.Anything, mock.Anything).Return(connectorInState(StatePaused), nil).Once()
+
+	client := &highLevelClient{client: mockBaseClient}
+	client.SetDeployOptions(DeployOptions{Strategy: StrategyPauseUpdateResume})
+	err := client.DeployConnector(CreateConnectorRequest{
+		ConnectorRequest: ConnectorRequest{"test1"},
+		Config:           map[string]interface{}{"param1": 3},
+	})
+
+	assert.NoError(t, err)
+	mockBaseClient.AssertExpectations(t)
+	mockBaseClient.AssertNotCalled(t, "PauseConnectorContext", mock.Anything, mock.Anything)
+	mockBaseClient.AssertNotCalled(t, "ResumeConnectorContext", mock.Anything, mock.Anything)
+}
+
+func Test_DeployConnector_In_Place_Resume_Paused(t *testing.T) {
+	mockBaseClient := mockExistingConnector()
+	// before deploying, before resuming, once resumed
+	mockBaseClient.On("GetConnectorStatusContext", mock.Anything, mock.Anything).Return(connectorInState(StatePaused), nil).Twice()
+	mockBaseClient.On("ResumeConnectorContext", mock.Anything, mock.Anything).Return(EmptyResponse{}, nil)
+	mockBaseClient.On("GetConnectorStatusContext", mock.Anything, mock.Anything).Return(connectorInState(StateRunning), nil).Once()
+
+	client := &highLevelClient{client: mockBaseClient}
+	client.SetDeployOptions(DeployOptions{ResumePaused: true})
+	err := client.DeployConnector(CreateConnectorRequest{
+		ConnectorRequest: ConnectorRequest{"test1"},
+		Config:           map[string]interface{}{"param1": 3},
+	})
+
+	assert.NoError(t, err)
+	mockBaseClient.AssertExpectations(t)
+	mockBaseClient.AssertNotCalled(t, "PauseConnectorContext", mock.Anything, mock.Anything)
+}
+
+// mockPausedConnector mocks a paused connector on a server of the given version
+func mockPausedConnector(serverVersion string) *MockBaseClient {
+	mockBaseClient := &MockBaseClient{}
+	mockBaseClient.On("GetConnectorContext", mock.Anything, mock.Anything).
+		Return(ConnectorResponse{Name: "test1", Config: map[string]interface{}{"name": "test1", "param1": "2"}}, nil).Once()
+	mockBaseClient.On("GetConnectorConfigContext", mock.Anything, mock.Anything).
+		Return(GetConnectorConfigResponse{Config: map[string]interface{}{"name": "test1", "param1": "2"}}, nil).Once()
+	mockBaseClient.On("GetConnectorStatusContext", mock.Anything, mock.Anything).Return(connectorInState(StatePaused), nil).Once()
+	mockBaseClient.On("GetWorkerInfoContext", mock.Anything).Return(WorkerInfoResponse{Version: serverVersion}, nil)
+	return mockBaseClient
+}
+
+func Test_DeployConnector_Recreate_Stays_Paused(t *testing.T) {
+	mockBaseClient := mockPausedConnector("3.7.0")
+	// once deleted, once created
+	mockBaseClient.On("DeleteConnectorContext", mock.Anything, ConnectorRequest{Name: "test1"}).Return(EmptyResponse{}, nil)
+	mockBaseClient.On("GetConnectorContext", mock.Anything, mock.Anything).
+		Return(ConnectorResponse{EmptyResponse: EmptyResponse{Code: 404}}, nil).Once()
+	mockBaseClient.On("CreateConnectorContext", mock.Anything, mock.MatchedBy(func(req CreateConnectorRequest) bool {
+		return req.InitialState == StatePaused
+	})).Return(ConnectorResponse{Name: "test1"}, nil)
+	mockBaseClient.On("GetConnectorContext", mock.Anything, mock.Anything).
+		Return(ConnectorResponse{EmptyResponse: EmptyResponse{Code: 200}, Name: "test1"}, nil).Once()
+
+	client := &highLevelClient{client: mockBaseClient, versionCheck: true}
+	client.SetDeployOptions(DeployOptions{Strategy: StrategyRecreate})
+	err := client.DeployConnector(CreateConnectorRequest{
+		ConnectorRequest: ConnectorRequest{"test1"},
+		Config:           map[string]interface{}{"param1": 3},
+	})
+
+	assert.NoError(t, err)
+	mockBaseClient.AssertExpectations(t)
+	// the connector is created paused, it never runs
+	mockBaseClient.AssertNotCalled(t, "PauseConnectorContext", mock.Anything, mock.Anything)
+	mockBaseClient.AssertNotCalled(t, "ResumeConnectorContext", mock.Anything, mock.Anything)
+}
+
+func Test_DeployConnector_Recreate_Create_Failed(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	mockBaseClient := &MockBaseClient{}
+	mockBaseClient.On("GetConnectorContext", mock.Anything, mock.Anything).
+		Return(ConnectorResponse{Name: "test1", Config: map[string]interface{}{"name": "test1", "param1": "2"}}, nil).Once()
+	mockBaseClient.On("GetConnectorConfigContext", mock.Anything, mock.Anything).
+		Return(GetConnectorConfigResponse{Config: map[string]interface{}{"name": "test1", "param1": "2"}}, nil).Once()
+	mockBaseClient.On("GetConnectorStatusContext", mock.Anything, mock.Anything).Return(connectorInState(StateRunning), nil).Once()
+	// once deleted
+	mockBaseClient.On("DeleteConnectorContext", mock.Anything, ConnectorRequest{Name: "test1"}).Return(EmptyResponse{}, nil)
+	mockBaseClient.On("GetConnectorContext", mock.Anything, mock.Anything).
+		Return(ConnectorResponse{EmptyResponse: EmptyResponse{Code: 404}}, nil).Once()
+	// the deploy times out while creating the new config
+	mockBaseClient.On("CreateConnectorContext", mock.Anything, mock.MatchedBy(func(req CreateConnectorRequest) bool {
+		return req.Config["param1"] == 3
+	})).
+		Run(func(args mock.Arguments) { cancel() }).
+		Return(ConnectorResponse{}, context.Canceled)
+	// the previous config is created again, once created
+	mockBaseClient.On("CreateConnectorContext", mock.Anything, mock.MatchedBy(func(req CreateConnectorRequest) bool {
+		return req.Config["param1"] == "2"
+	})).
+		Run(func(args mock.Arguments) { assert.NoError(t, args.Get(0).(context.Context).Err()) }).
+		Return(ConnectorResponse{Name: "test1"}, nil)
+	mockBaseClient.On("GetConnectorContext", mock.Anything, mock.Anything).
+		Return(ConnectorResponse{EmptyResponse: EmptyResponse{Code: 200}, Name: "test1"}, nil).Once()
+
+	client := &highLevelClient{client: mockBaseClient}
+	client.SetDeployOptions(DeployOptions{Strategy: StrategyRecreate})
+	err := client.DeployConnectorContext(ctx, CreateConnectorRequest{
+		ConnectorRequest: ConnectorRequest{"test1"},
+		Config:           map[string]interface{}{"param1": 3},
+	})
+
+	assert.Error(t, err)
+	assert.Equal(t, context.Canceled, errors.Cause(err))
+	// the connector is not left deleted
+	mockBaseClient.AssertNumberOfCalls(t, "CreateConnectorContext", 2)
+	mockBaseClient.AssertExpectations(t)
+}
+
+func Test_DeployConnector_Recreate_Restore_Failed(t *testing.T) {
+	mockBaseClient := &MockBaseClient{}
+	mockBaseClient.On("GetConnectorContext", mock.Anything, mock.Anything).
+		Return(ConnectorResponse{Name: "test1", Config: map[string]interface{}{"name": "test1", "param1": "2"}}, nil).Once()
+	mockBaseClient.On("GetConnectorConfigContext", mock.Anything, mock.Anything).
+		Return(GetConnectorConfigResponse{Config: map[string]interface{}{"name": "test1", "param1": "2"}}, nil).Once()
+	mockBaseClient.On("GetConnectorStatusContext", mock.Anything, mock.Anything).Return(connectorInState(StateRunning), nil).Once()
+	mockBaseClient.On("DeleteConnectorContext", mock.Anything, ConnectorRequest{Name: "test1"}).Return(EmptyResponse{}, nil)
+	mockBaseClient.On("GetConnectorContext", mock.Anything, mock.Anything).
+		Return(ConnectorResponse{EmptyResponse: EmptyResponse{Code: 404}}, nil).Once()
+	mockBaseClient.On("CreateConnectorContext", mock.Anything, mock.Anything).
+		Return(ConnectorResponse{}, errors.New("unreachable")).Twice()
+
+	client := &highLevelClient{client: mockBaseClient}
+	client.SetDeployOptions(DeployOptions{Strategy: StrategyRecreate})
+	err := client.DeployConnector(CreateConnectorRequest{
+		ConnectorRequest: ConnectorRequest{"test1"},
+		Config:           map[string]interface{}{"param1": 3},
+	})
+
+	assert.EqualError(t, err, "connector test1 may be left deleted, could not create it again with its previous config: unreachable: unreachable")
+	mockBaseClient.AssertExpectations(t)
+}
+
+func Test_DeployConnector_Recreate_Paused_Unsupported(t *testing.T) {
+	mockBaseClient := mockPausedConnector("3.6.0")
+
+	client := &highLevelClient{client: mockBaseClient, versionCheck: true}
+	client.SetDeployOptions(DeployOptions{Strategy: StrategyRecreate})
+	err := client.DeployConnector(CreateConnectorRequest{
+		ConnectorRequest: ConnectorRequest{"test1"},
+		Config:           map[string]interface{}{"param1": 3},
+	})
+
+	assert.True(t, errors.Is(err, ErrUnsupportedByServer))
+	mockBaseClient.AssertExpectations(t)
+	mockBaseClient.AssertNotCalled(t, "DeleteConnectorContext", mock.Anything, mock.Anything)
+	mockBaseClient.AssertNotCalled(t, "CreateConnectorContext", mock.Anything, mock.Anything)
+}
+
+func Test_DeployConnector_Strategy_Ignored_On_Create(t *testing.T) {
+	mockBaseClient := &MockBaseClient{}
+	mockBaseClient.On("GetConnectorContext", mock.Anything, mock.Anything).
+		Return(ConnectorResponse{EmptyResponse: EmptyResponse{Code: 404}}, nil)
+	mockBaseClient.On("UpdateConnectorContext", mock.Anything, mock.Anything).Return(ConnectorResponse{}, nil)
+	mockBaseClient.On("GetConnectorConfigContext", mock.Anything, mock.Anything).
+		Return(GetConnectorConfigResponse{Config: map[string]interface{}{"name": "test1", "param1": "3"}}, nil)
+
+	client := &highLevelClient{client: mockBaseClient}
+	client.SetDeployOptions(DeployOptions{Strategy: StrategyRecreate})
+	err := client.DeployConnector(CreateConnectorRequest{
+		ConnectorRequest: ConnectorRequest{"test1"},
+		Config:           map[string]interface{}{"param1": 3},
+	})
+
+	assert.NoError(t, err)
+	mockBaseClient.AssertNotCalled(t, "DeleteConnectorContext", mock.Anything, mock.Anything)
+	mockBaseClient.AssertNotCalled(t, "GetConnectorStatusContext", mock.Anything, mock.Anything)
+}
@@ -0,0 +1,117 @@
+package connectors
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/pkg/errors"
+)
+
+//WithCACertFile trusts the certificate authorities of a PEM file, in addition to the system ones
+//It is meant for clusters using certificates signed by an internal CA
+func WithCACertFile(path string) Option {
+	return func(o *clientOptions) error {
+		pem, err := ioutil.ReadFile(path)
+		if err != nil {
+			return errors.Wrap(err, "could not read CA certificate")
+		}
+		return appendCACerts(o.tls(), pem, path)
+	}
+}
+
+//WithCACertPEM trusts the given PEM encoded certificate authorities, in addition to the system ones
+func WithCACertPEM(pem []byte) Option {
+	return func(o *clientOptions) error {
+		return appendCACerts(o.tls(), pem, "PEM data")
+	}
+}
+
+//WithServerName overrides the name used to verify the certificate of kafka-connect
+//e.g. when workers are reached through their IP address
+func WithServerName(name string) Option {
+	return func(o *clientOptions) error {
+		o.tls().ServerName = name
+		return nil
+	}
+}
+
+//WithMinTLSVersion sets the minimum TLS version accepted, e.g. tls.VersionTLS12
+func WithMinTLSVersion(version uint16) Option {
+	return func(o *clientOptions) error {
+		if version < tls.VersionTLS10 || version > tls.VersionTLS13 {
+			return errors.Errorf("invalid TLS version: %#x", version)
+		}
+		o.tls().MinVersion = version
+		return nil
+	}
+}
+
+//WithClientCertificatePEM authenticates the client with the given PEM encoded certificate and private key
+func WithClientCertificatePEM(certPEM []byte, keyPEM []byte) Option {
+	return func(o *clientOptions) error {
+		cert, err := tls.X509KeyPair(certPEM, keyPEM)
+		if err != nil {
+			return errors.Wrap(err, "invalid client certificate")
+		}
+		config := o.tls()
+		config.Certificates = append(config.Certificates, cert)
+		return nil
+	}
+}
+
+//WithClientCertificateFiles authenticates the client with the certificate and private key of the given PEM files
+func WithClientCertificateFiles(certFile string, keyFile string) Option {
+	return func(o *clientOptions) error {
+		cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+		if err != nil {
+			return errors.Wrap(err, "invalid client certificate")
+		}
+		config := o.tls()
+		config.Certificates = append(config.Certificates, cert)
+		return nil
+	}
+}
+
+// tls returns the TLS config being built by options, creating it if needed
+func (o *clientOptions) tls() *tls.Config {
+	if o.tlsConfig == nil {
+		o.tlsConfig = &tls.Config{}
+	}
+	return o.tlsConfig
+}
+
+func appendCACerts(config *tls.Config, pem []byte, source string) error {
+	if config.RootCAs == nil {
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		config.RootCAs = pool
+	}
+	if !config.RootCAs.AppendCertsFromPEM(pem) {
+		return errors.Errorf("no CA certificate found in %v", source)
+	}
+	return nil
+}
+
+// cloneTransportTLS returns a copy of transport, with a copy of its TLS config changed by update
+// The transport may be shared, e.g. given by WithTransport, or in use by requests, so it is never changed
+func cloneTransportTLS(transport http.RoundTripper, update func(config *tls.Config)) (*http.Transport, error) {
+	var clone *http.Transport
+	switch t := transport.(type) {
+	case nil:
+		clone = http.DefaultTransport.(*http.Transport).Clone()
+	case *http.Transport:
+		// the TLS config is cloned along
+		clone = t.Clone()
+	default:
+		return nil, errors.Errorf("TLS config can only be set on an *http.Transport, got %T", transport)
+	}
+	if clone.TLSClientConfig == nil {
+		clone.TLSClientConfig = &tls.Config{}
+	}
+	update(clone.TLSClientConfig)
+	return clone, nil
+}
@@ -0,0 +1,115 @@
+//go:build !integration
+
+package connectors
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// newTestCertificate returns a self-signed PEM encoded certificate and its private key
+func newTestCertificate(t *testing.T) ([]byte, []byte) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "connect"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	assert.NoError(t, err)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	assert.NoError(t, err)
+
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+	return certPEM, keyPEM
+}
+
+func Test_NewClientWithOptions_TLS(t *testing.T) {
+	certPEM, keyPEM := newTestCertificate(t)
+
+	client, err := NewClientWithOptions("https://randomurl",
+		WithCACertPEM(certPEM),
+		WithServerName("connect"),
+		WithMinTLSVersion(tls.VersionTLS12),
+		WithClientCertificatePEM(certPEM, keyPEM),
+	)
+	assert.NoError(t, err)
+	client.SetInsecureSSL()
+
+	restClient := client.(*highLevelClient).client.(*baseClient).restClient
+	config := restClient.GetClient().Transport.(*http.Transport).TLSClientConfig
+	assert.NotNil(t, config.RootCAs)
+	assert.Equal(t, "connect", config.ServerName)
+	assert.Equal(t, uint16(tls.VersionTLS12), config.MinVersion)
+	assert.Len(t, config.Certificates, 1)
+	// setters keep the config built by options
+	assert.True(t, config.InsecureSkipVerify)
+}
+
+func Test_SetInsecureSSL_Given_Transport_Unchanged(t *testing.T) {
+	certPEM, keyPEM := newTestCertificate(t)
+	cert, err := tls.X509KeyPair(certPEM, keyPEM)
+	assert.NoError(t, err)
+	shared := &http.Transport{TLSClientConfig: &tls.Config{ServerName: "connect"}}
+
+	client, err := NewClientWithOptions("https://randomurl", WithTransport(shared))
+	assert.NoError(t, err)
+	client.SetInsecureSSL()
+	client.SetClientCertificates(cert)
+
+	transport := client.(*highLevelClient).client.(*baseClient).restClient.GetClient().Transport.(*http.Transport)
+	assert.True(t, transport != shared)
+	assert.True(t, transport.TLSClientConfig.InsecureSkipVerify)
+	assert.Equal(t, "connect", transport.TLSClientConfig.ServerName)
+	assert.Len(t, transport.TLSClientConfig.Certificates, 1)
+	assert.False(t, shared.TLSClientConfig.InsecureSkipVerify)
+	assert.Empty(t, shared.TLSClientConfig.Certificates)
+}
+
+func Test_SetInsecureSSL_Multi_Worker_Given_Transport_Unchanged(t *testing.T) {
+	shared := &http.Transport{TLSClientConfig: &tls.Config{}}
+
+	client, err := NewMultiWorkerClient([]string{"https://worker1", "https://worker2"}, WithTransport(shared),
+		WithWorkerCheckInterval(0))
+	assert.NoError(t, err)
+	client.SetInsecureSSL()
+
+	pool := client.(*multiWorkerClient).pool
+	transport := pool.transport().(*http.Transport)
+	assert.True(t, transport != shared)
+	assert.True(t, transport.TLSClientConfig.InsecureSkipVerify)
+	assert.False(t, shared.TLSClientConfig.InsecureSkipVerify)
+}
+
+func Test_NewClientWithOptions_Invalid_TLS(t *testing.T) {
+	certPEM, _ := newTestCertificate(t)
+
+	_, err := NewClientWithOptions("https://randomurl", WithCACertPEM([]byte("not a certificate")))
+	assert.EqualError(t, err, "no CA certificate found in PEM data")
+
+	_, err = NewClientWithOptions("https://randomurl", WithCACertFile("missing.pem"))
+	assert.Error(t, err)
+
+	_, err = NewClientWithOptions("https://randomurl", WithMinTLSVersion(0x0200))
+	assert.Error(t, err)
+
+	_, err = NewClientWithOptions("https://randomurl", WithClientCertificatePEM(certPEM, []byte("not a key")))
+	assert.Error(t, err)
+}
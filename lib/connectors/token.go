@@ -0,0 +1,176 @@
+package connectors
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+//TokenSource provides the bearer token sent with every request, see SetTokenSource
+type TokenSource interface {
+	Token(ctx context.Context) (string, error)
+}
+
+type staticTokenSource string
+
+//StaticToken returns a TokenSource which always provides the given token
+func StaticToken(token string) TokenSource {
+	return staticTokenSource(token)
+}
+
+func (s staticTokenSource) Token(ctx context.Context) (string, error) {
+	return string(s), nil
+}
+
+type fileTokenSource struct {
+	path string
+
+	lock    sync.Mutex
+	token   string
+	modTime time.Time
+	size    int64
+}
+
+//FileToken returns a TokenSource reading the token from a file, e.g. one mounted from a kubernetes secret
+//The file is read again whenever it changed, surrounding whitespaces are ignored
+func FileToken(path string) TokenSource {
+	return &fileTokenSource{path: path}
+}
+
+func (s *fileTokenSource) Token(ctx context.Context) (string, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	info, err := os.Stat(s.path)
+	if err != nil {
+		return "", errors.Wrap(err, "could not read token file")
+	}
+	if s.token != "" && info.ModTime().Equal(s.modTime) && info.Size() == s.size {
+		return s.token, nil
+	}
+
+	content, err := ioutil.ReadFile(s.path)
+	if err != nil {
+		return "", errors.Wrap(err, "could not read token file")
+	}
+	token := strings.TrimSpace(string(content))
+	if token == "" {
+		return "", errors.Errorf("token file %v is empty", s.path)
+	}
+	s.token, s.modTime, s.size = token, info.ModTime(), info.Size()
+	return s.token, nil
+}
+
+//ClientCredentialsConfig configures the OAuth2 client credentials flow, see ClientCredentialsToken
+type ClientCredentialsConfig struct {
+	TokenURL     string
+	ClientID     string
+	ClientSecret string
+	Scopes       []string
+	// CredentialsInBody sends client id and secret as form parameters rather than with basic auth
+	CredentialsInBody bool
+	// RefreshBefore is how long before its expiry a token is replaced, 1 minute by default
+	RefreshBefore time.Duration
+	// HTTPClient is used to get tokens, a client with a 10 seconds timeout by default
+	HTTPClient *http.Client
+}
+
+type clientCredentialsTokenSource struct {
+	config ClientCredentialsConfig
+
+	lock   sync.Mutex
+	token  string
+	expiry time.Time
+}
+
+//ClientCredentialsToken returns a TokenSource getting tokens with the OAuth2 client credentials flow
+//Tokens are cached, and a new one is requested shortly before the current one expires
+func ClientCredentialsToken(config ClientCredentialsConfig) TokenSource {
+	if config.RefreshBefore <= 0 {
+		config.RefreshBefore = time.Minute
+	}
+	if config.HTTPClient == nil {
+		config.HTTPClient = &http.Client{Timeout: 10 * time.Second}
+	}
+	return &clientCredentialsTokenSource{config: config}
+}
+
+// tokenResponse is the successful response of an OAuth2 token endpoint
+type tokenResponse struct {
+	AccessToken string `json:"access_token"`
+	TokenType   string `json:"token_type"`
+	ExpiresIn   int64  `json:"expires_in"`
+}
+
+func (s *clientCredentialsTokenSource) Token(ctx context.Context) (string, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	// a token without expiry is kept until the client is discarded
+	if s.token != "" && (s.expiry.IsZero() || time.Now().Add(s.config.RefreshBefore).Before(s.expiry)) {
+		return s.token, nil
+	}
+
+	resp, err := s.requestToken(ctx)
+	if err != nil {
+		return "", err
+	}
+	s.token = resp.AccessToken
+	s.expiry = time.Time{}
+	if resp.ExpiresIn > 0 {
+		s.expiry = time.Now().Add(time.Duration(resp.ExpiresIn) * time.Second)
+	}
+	return s.token, nil
+}
+
+func (s *clientCredentialsTokenSource) requestToken(ctx context.Context) (tokenResponse, error) {
+	form := url.Values{"grant_type": {"client_credentials"}}
+	if len(s.config.Scopes) > 0 {
+		form.Set("scope", strings.Join(s.config.Scopes, " "))
+	}
+	if s.config.CredentialsInBody {
+		form.Set("client_id", s.config.ClientID)
+		form.Set("client_secret", s.config.ClientSecret)
+	}
+
+	req, err := http.NewRequest(http.MethodPost, s.config.TokenURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return tokenResponse{}, errors.Wrap(err, "invalid token url")
+	}
+	req = req.WithContext(ctx)
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	if !s.config.CredentialsInBody {
+		req.SetBasicAuth(url.QueryEscape(s.config.ClientID), url.QueryEscape(s.config.ClientSecret))
+	}
+
+	resp, err := s.config.HTTPClient.Do(req)
+	if err != nil {
+		return tokenResponse{}, errors.Wrap(err, "could not get token")
+	}
+	defer resp.Body.Close()
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return tokenResponse{}, errors.Wrap(err, "could not get token")
+	}
+	if resp.StatusCode >= 400 {
+		return tokenResponse{}, errors.Errorf("could not get token : %d %s", resp.StatusCode, body)
+	}
+
+	var result tokenResponse
+	if err := json.Unmarshal(body, &result); err != nil {
+		return tokenResponse{}, errors.Wrap(err, "invalid token response")
+	}
+	if result.AccessToken == "" {
+		return tokenResponse{}, errors.New("invalid token response: missing access_token")
+	}
+	return result, nil
+}
@@ -0,0 +1,145 @@
+//go:build !integration
+
+package connectors
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/jarcoal/httpmock.v1"
+)
+
+func Test_FileToken_Reread_On_Change(t *testing.T) {
+	dir, err := ioutil.TempDir("", "token")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "token")
+	assert.NoError(t, ioutil.WriteFile(path, []byte("first\n"), 0600))
+
+	source := FileToken(path)
+	token, err := source.Token(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, "first", token)
+
+	assert.NoError(t, ioutil.WriteFile(path, []byte("second\n"), 0600))
+	// make sure the change is visible even on file systems with a coarse modification time
+	assert.NoError(t, os.Chtimes(path, time.Now(), time.Now().Add(time.Minute)))
+
+	token, err = source.Token(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, "second", token)
+}
+
+func Test_ClientCredentialsToken_Cached(t *testing.T) {
+	transport := httpmock.NewMockTransport()
+	calls := 0
+	transport.RegisterResponder("POST", "http://auth/token", func(req *http.Request) (*http.Response, error) {
+		calls++
+		user, password, _ := req.BasicAuth()
+		assert.Equal(t, "client", user)
+		assert.Equal(t, "secret", password)
+		assert.NoError(t, req.ParseForm())
+		assert.Equal(t, "client_credentials", req.PostForm.Get("grant_type"))
+		assert.Equal(t, "connect:read connect:write", req.PostForm.Get("scope"))
+
+		// first token expires within RefreshBefore, so it is replaced on next call
+		expiresIn := 30
+		if calls > 1 {
+			expiresIn = 3600
+		}
+		return httpmock.NewJsonResponse(200, map[string]interface{}{
+			"access_token": "token" + string(rune('0'+calls)),
+			"token_type":   "Bearer",
+			"expires_in":   expiresIn,
+		})
+	})
+
+	source := ClientCredentialsToken(ClientCredentialsConfig{
+		TokenURL:     "http://auth/token",
+		ClientID:     "client",
+		ClientSecret: "secret",
+		Scopes:       []string{"connect:read", "connect:write"},
+		HTTPClient:   &http.Client{Transport: transport},
+	})
+
+	for _, expected := range []string{"token1", "token2", "token2"} {
+		token, err := source.Token(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, expected, token)
+	}
+	assert.Equal(t, 2, calls)
+}
+
+func Test_ClientCredentialsToken_Error(t *testing.T) {
+	transport := httpmock.NewMockTransport()
+	transport.RegisterResponder("POST", "http://auth/token", httpmock.NewStringResponder(401, `{"error":"invalid_client"}`))
+
+	source := ClientCredentialsToken(ClientCredentialsConfig{
+		TokenURL:   "http://auth/token",
+		HTTPClient: &http.Client{Transport: transport},
+	})
+	_, err := source.Token(context.Background())
+
+	assert.EqualError(t, err, `could not get token : 401 {"error":"invalid_client"}`)
+}
+
+func Test_TokenSource_Sent_With_Requests(t *testing.T) {
+	client := newBaseClient("http://randomurl")
+	client.SetTokenSource(StaticToken("my-token"))
+	// mock HTTP response
+	{
+		typedClient := client.(*baseClient)
+		httpmock.Reset()
+		httpmock.ActivateNonDefault(typedClient.restClient.GetClient())
+		defer httpmock.DeactivateAndReset()
+
+		httpmock.RegisterResponder("GET", "http://randomurl/connectors", func(req *http.Request) (*http.Response, error) {
+			if req.Header.Get("Authorization") != "Bearer my-token" {
+				return httpmock.NewStringResponse(401, ""), nil
+			}
+			return httpmock.NewJsonResponse(200, []string{"test"})
+		})
+	}
+
+	//Act
+	resp, err := client.GetAll()
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"test"}, resp.Connectors)
+}
+
+func Test_TokenSource_Sent_With_Every_Request(t *testing.T) {
+	var authorizations []string
+	client := newBaseClient("http://randomurl")
+	client.SetTokenSource(StaticToken("my-token"))
+	// mock HTTP response
+	{
+		typedClient := client.(*baseClient)
+		httpmock.Reset()
+		httpmock.ActivateNonDefault(typedClient.restClient.GetClient())
+		defer httpmock.DeactivateAndReset()
+
+		httpmock.RegisterNoResponder(func(req *http.Request) (*http.Response, error) {
+			authorizations = append(authorizations, req.Header.Get("Authorization"))
+			return httpmock.NewStringResponse(200, ""), nil
+		})
+	}
+
+	for _, test := range baseClientCalls {
+		t.Run(test.operation, func(t *testing.T) {
+			authorizations = nil
+
+			//Act
+			err := test.call(client)
+
+			assert.NoError(t, err)
+			assert.Equal(t, []string{"Bearer my-token"}, authorizations)
+		})
+	}
+}
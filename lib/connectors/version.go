@@ -0,0 +1,91 @@
+package connectors
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+//ErrUnsupportedByServer is returned when an endpoint is not supported by the kafka-connect version of the server
+//Use errors.As with *UnsupportedFeatureError to get details
+var ErrUnsupportedByServer = errors.New("unsupported by server")
+
+//UnsupportedFeatureError is returned, before any request is sent, when a feature requires a newer kafka-connect
+type UnsupportedFeatureError struct {
+	Feature       string
+	ServerVersion string
+	MinVersion    string
+}
+
+func (err *UnsupportedFeatureError) Error() string {
+	return fmt.Sprintf("%s requires kafka-connect %s or later, server version is %s", err.Feature, err.MinVersion, err.ServerVersion)
+}
+
+//Is makes errors.Is(err, ErrUnsupportedByServer) true
+func (err *UnsupportedFeatureError) Is(target error) bool {
+	return target == ErrUnsupportedByServer
+}
+
+// Minimum kafka-connect version of features which are not available on every server
+const (
+	minVersionExpand            = "2.3.0"
+	minVersionLoggers           = "2.4.0"
+	minVersionTopics            = "2.5.0"
+	minVersionRestartOptions    = "3.0.0"
+	minVersionStop              = "3.5.0"
+	minVersionGetOffsets        = "3.5.0"
+	minVersionAlterOffsets      = "3.6.0"
+	minVersionClusterScopedLogs = "3.7.0"
+	minVersionInitialState      = "3.7.0"
+)
+
+// version is a parsed kafka-connect version, only major and minor matter to know which endpoints are supported
+type version struct {
+	major int
+	minor int
+}
+
+func (v version) before(other version) bool {
+	return v.major < other.major || (v.major == other.major && v.minor < other.minor)
+}
+
+// parseVersion parses versions such as "3.5.0", "3.6.1-SNAPSHOT" or confluent platform ones such as "7.4.0-ccs"
+func parseVersion(raw string) (version, error) {
+	parts := strings.SplitN(raw, "-", 2)
+	numbers := strings.Split(parts[0], ".")
+	if len(numbers) < 2 {
+		return version{}, errors.Errorf("invalid version: %v", raw)
+	}
+
+	major, err := strconv.Atoi(numbers[0])
+	if err != nil {
+		return version{}, errors.Wrapf(err, "invalid version: %v", raw)
+	}
+	minor, err := strconv.Atoi(numbers[1])
+	if err != nil {
+		return version{}, errors.Wrapf(err, "invalid version: %v", raw)
+	}
+
+	v := version{major: major, minor: minor}
+	if len(parts) == 2 && (strings.HasPrefix(parts[1], "ccs") || strings.HasPrefix(parts[1], "ce")) {
+		v = confluentToKafkaVersion(v)
+	}
+	return v, nil
+}
+
+// confluentToKafkaVersion converts a confluent platform version to the apache kafka version it is based on
+// e.g. 5.5 is 2.5, 6.0 is 2.6, 7.4 is 3.4
+func confluentToKafkaVersion(v version) version {
+	switch {
+	case v.major == 5:
+		return version{major: 2, minor: v.minor}
+	case v.major == 6:
+		return version{major: 2, minor: v.minor + 6}
+	case v.major >= 7:
+		return version{major: v.major - 4, minor: v.minor}
+	default:
+		return v
+	}
+}
@@ -0,0 +1,66 @@
+//go:build !integration
+
+package connectors
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_parseVersion(t *testing.T) {
+	cases := map[string]version{
+		"3.5.0":          {major: 3, minor: 5},
+		"3.6.1-SNAPSHOT": {major: 3, minor: 6},
+		"2.8":            {major: 2, minor: 8},
+		"5.5.1-ccs":      {major: 2, minor: 5},
+		"6.1.0-ccs":      {major: 2, minor: 7},
+		"7.4.0-ce":       {major: 3, minor: 4},
+	}
+
+	for raw, expected := range cases {
+		actual, err := parseVersion(raw)
+		assert.NoError(t, err, raw)
+		assert.Equal(t, expected, actual, raw)
+	}
+
+	_, err := parseVersion("not a version")
+	assert.Error(t, err)
+}
+
+func Test_StopConnector_Unsupported_By_Server(t *testing.T) {
+	mockBaseClient := &MockBaseClient{}
+	mockBaseClient.On("GetWorkerInfoContext", mock.Anything).
+		Return(WorkerInfoResponse{Version: "3.4.0"}, nil).Once()
+	// note we don't mock StopConnector because no request should be sent
+
+	client := &highLevelClient{client: mockBaseClient, versionCheck: true}
+	_, err := client.StopConnector(ConnectorRequest{Name: "test1"}, false)
+
+	assert.True(t, errors.Is(err, ErrUnsupportedByServer))
+	var unsupportedErr *UnsupportedFeatureError
+	assert.True(t, errors.As(err, &unsupportedErr))
+	assert.Equal(t, "3.4.0", unsupportedErr.ServerVersion)
+
+	// version is detected once only
+	_, err = client.GetConnectorOffsets(ConnectorRequest{Name: "test1"})
+	assert.True(t, errors.Is(err, ErrUnsupportedByServer))
+	mockBaseClient.AssertExpectations(t)
+}
+
+func Test_GetConnectorTopics_Supported_By_Server(t *testing.T) {
+	mockBaseClient := &MockBaseClient{}
+	mockBaseClient.On("GetWorkerInfoContext", mock.Anything).
+		Return(WorkerInfoResponse{Version: "3.4.0"}, nil).Once()
+	mockBaseClient.On("GetConnectorTopicsContext", mock.Anything, ConnectorRequest{Name: "test1"}).
+		Return(GetConnectorTopicsResponse{Topics: []string{"topic1"}}, nil)
+
+	client := &highLevelClient{client: mockBaseClient, versionCheck: true}
+	resp, err := client.GetConnectorTopics(ConnectorRequest{Name: "test1"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"topic1"}, resp.Topics)
+	mockBaseClient.AssertExpectations(t)
+}
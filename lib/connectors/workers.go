@@ -0,0 +1,341 @@
+package connectors
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+//MultiWorkerClient is a HighLevelClient sending requests to several workers of the same kafka-connect cluster
+type MultiWorkerClient interface {
+	HighLevelClient
+	//CheckWorkers calls every worker, and returns the error of those which are unhealthy, nil for others, indexed by url
+	//Unhealthy workers are skipped by next requests, until they answer again or their cooldown elapsed
+	CheckWorkers(ctx context.Context) map[string]error
+	//Close stops checking workers in the background, the client can still be used
+	Close() error
+}
+
+type multiWorkerClient struct {
+	*highLevelClient
+	pool *workerPool
+	// stop ends background checks
+	stop context.CancelFunc
+}
+
+//NewMultiWorkerClient generates a client for a cluster made of the given workers, e.g. when there is no load balancer in front of them
+//Reads are spread across workers, and requests fail over to the next worker when one cannot be reached.
+//Mutating requests go to the worker which handled the last one successfully, as long as no worker answers
+//it could not forward the request to the leader, in which case they are sent to the next worker.
+//Workers are checked at once, then in the background every check interval until Close is called, see WithWorkerCheckInterval
+func NewMultiWorkerClient(urls []string, opts ...Option) (MultiWorkerClient, error) {
+	if len(urls) == 0 {
+		return nil, errors.New("at least one worker url is required")
+	}
+	options := defaultClientOptions()
+	for _, opt := range opts {
+		if err := opt(&options); err != nil {
+			return nil, err
+		}
+	}
+
+	client, err := newBaseClientWithOptions(strings.TrimSpace(urls[0]), options)
+	if err != nil {
+		return nil, err
+	}
+	httpClient := client.restClient.GetClient()
+	pool, err := newWorkerPool(urls, httpClient.Transport, options.workerCooldown)
+	if err != nil {
+		return nil, err
+	}
+	httpClient.Transport = pool
+
+	multiClient := &multiWorkerClient{
+		highLevelClient: &highLevelClient{
+			client:             client,
+			maxParallelRequest: options.parallelism,
+			waitOptions:        options.waitOptions,
+			healthCheck:        options.healthCheck,
+			deployOptions:      options.deployOptions,
+			operations:         operationInterceptors(options.interceptors),
+			logger:             options.logger,
+			versionCheck:       true,
+		},
+		pool: pool,
+		stop: func() {},
+	}
+	if options.workerCheck > 0 {
+		var ctx context.Context
+		ctx, multiClient.stop = context.WithCancel(context.Background())
+		go multiClient.checkPeriodically(ctx, options.workerCheck, options.timeout)
+	}
+	return multiClient, nil
+}
+
+//CheckWorkers calls every worker, and returns the error of those which are unhealthy, nil for others, indexed by url
+func (c *multiWorkerClient) CheckWorkers(ctx context.Context) map[string]error {
+	return c.pool.check(ctx)
+}
+
+//Close stops checking workers in the background, the client can still be used
+func (c *multiWorkerClient) Close() error {
+	c.stop()
+	return nil
+}
+
+// checkPeriodically checks workers at once, then every interval until ctx is done
+func (c *multiWorkerClient) checkPeriodically(ctx context.Context, interval time.Duration, timeout time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		c.checkWorkers(ctx, timeout)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// checkWorkers checks every worker once, timeout bounds the whole check unless it is 0
+func (c *multiWorkerClient) checkWorkers(ctx context.Context, timeout time.Duration) {
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+	c.pool.check(ctx)
+}
+
+type worker struct {
+	url *url.URL
+
+	lock      sync.Mutex
+	downUntil time.Time
+}
+
+func (w *worker) isDown(now time.Time) bool {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	return now.Before(w.downUntil)
+}
+
+func (w *worker) markDown(cooldown time.Duration) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	w.downUntil = time.Now().Add(cooldown)
+}
+
+func (w *worker) markUp() {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	w.downUntil = time.Time{}
+}
+
+// workerPool is a transport sending each request to one of the workers, whatever the host of its url
+type workerPool struct {
+	workers  []*worker
+	basePath string
+	// next holds a nextTransport, it is replaced rather than changed by client setters, while requests may be sent
+	next     atomic.Value
+	cooldown time.Duration
+
+	// reads is incremented by every read, to spread them across workers
+	reads uint32
+	// writer is the index of the worker mutating requests are sent to first
+	writer int32
+}
+
+func newWorkerPool(urls []string, next http.RoundTripper, cooldown time.Duration) (*workerPool, error) {
+	if next == nil {
+		next = http.DefaultTransport.(*http.Transport).Clone()
+	}
+	pool := &workerPool{cooldown: cooldown}
+	pool.setTransport(next)
+	for i, raw := range urls {
+		workerURL, err := url.Parse(strings.TrimRight(strings.TrimSpace(raw), "/"))
+		if err != nil || workerURL.Host == "" {
+			return nil, errors.Errorf("invalid worker url: %v", raw)
+		}
+		if i == 0 {
+			// requests are built against the first url, only its path is kept
+			pool.basePath = workerURL.Path
+		}
+		pool.workers = append(pool.workers, &worker{url: workerURL})
+	}
+	return pool, nil
+}
+
+// nextTransport wraps the transport of the pool, so that atomic.Value always holds the same type
+type nextTransport struct {
+	http.RoundTripper
+}
+
+// transport returns the transport requests are sent with to workers
+func (p *workerPool) transport() http.RoundTripper {
+	return p.next.Load().(nextTransport).RoundTripper
+}
+
+func (p *workerPool) setTransport(transport http.RoundTripper) {
+	p.next.Store(nextTransport{transport})
+}
+
+//RoundTrip implements http.RoundTripper
+func (p *workerPool) RoundTrip(req *http.Request) (*http.Response, error) {
+	mutating := req.Method != http.MethodGet && req.Method != http.MethodHead
+	// a body which cannot be read again prevents sending the request to another worker
+	canResend := req.Body == nil || req.Body == http.NoBody || req.GetBody != nil
+
+	next := p.transport()
+	var lastResp *http.Response
+	var lastErr error
+	for _, index := range p.order(mutating) {
+		w := p.workers[index]
+		attempt, err := p.rewrite(req, w)
+		if err != nil {
+			return nil, err
+		}
+		if lastResp != nil {
+			lastResp.Body.Close()
+			lastResp = nil
+		}
+
+		resp, err := next.RoundTrip(attempt)
+		if err != nil {
+			if req.Context().Err() != nil {
+				return nil, err
+			}
+			w.markDown(p.cooldown)
+			lastErr = err
+			// the request may have been handled if the connection was opened
+			if !canResend || (!isDialError(err) && !isIdempotent(req.Method)) {
+				return nil, err
+			}
+			continue
+		}
+		w.markUp()
+
+		if mutating {
+			resp, forwardErr := isForwardError(resp)
+			if forwardErr && canResend {
+				lastResp = resp
+				continue
+			}
+			atomic.StoreInt32(&p.writer, int32(index))
+			return resp, nil
+		}
+		return resp, nil
+	}
+
+	if lastResp != nil {
+		return lastResp, nil
+	}
+	return nil, lastErr
+}
+
+// order returns the index of workers in the order they should be tried, workers which are down come last
+func (p *workerPool) order(mutating bool) []int {
+	first := int(atomic.LoadInt32(&p.writer))
+	if !mutating {
+		first = int(atomic.AddUint32(&p.reads, 1)) % len(p.workers)
+	}
+
+	now := time.Now()
+	up := make([]int, 0, len(p.workers))
+	var down []int
+	for i := range p.workers {
+		index := (first + i) % len(p.workers)
+		if p.workers[index].isDown(now) {
+			down = append(down, index)
+		} else {
+			up = append(up, index)
+		}
+	}
+	return append(up, down...)
+}
+
+// rewrite returns a copy of req sent to the given worker
+func (p *workerPool) rewrite(req *http.Request, w *worker) (*http.Request, error) {
+	attempt := req.Clone(req.Context())
+	attempt.URL.Scheme = w.url.Scheme
+	attempt.URL.Host = w.url.Host
+	attempt.URL.Path = w.url.Path + strings.TrimPrefix(req.URL.Path, p.basePath)
+	if req.URL.RawPath != "" {
+		attempt.URL.RawPath = w.url.EscapedPath() + strings.TrimPrefix(req.URL.RawPath, p.basePath)
+	}
+	attempt.Host = ""
+
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return nil, err
+		}
+		attempt.Body = body
+	}
+	return attempt, nil
+}
+
+// check calls the root endpoint of every worker, and marks them up or down
+func (p *workerPool) check(ctx context.Context) map[string]error {
+	result := make(map[string]error, len(p.workers))
+	for _, w := range p.workers {
+		err := p.checkWorker(ctx, w)
+		if errors.Is(ctx.Err(), context.Canceled) {
+			// the check was interrupted, workers are left as they were
+			break
+		}
+		if err != nil {
+			w.markDown(p.cooldown)
+		} else {
+			w.markUp()
+		}
+		result[w.url.String()] = err
+	}
+	return result
+}
+
+func (p *workerPool) checkWorker(ctx context.Context, w *worker) error {
+	req, err := http.NewRequest(http.MethodGet, w.url.String()+"/", nil)
+	if err != nil {
+		return err
+	}
+	resp, err := p.transport().RoundTrip(req.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 500 {
+		return errors.Errorf("worker %v answered %d", w.url, resp.StatusCode)
+	}
+	return nil
+}
+
+// isForwardError tells whether a worker failed to forward a request to the leader
+// The response body is read, resp is returned with a body that can be read again
+func isForwardError(resp *http.Response) (*http.Response, bool) {
+	if resp.StatusCode != 409 && resp.StatusCode < 500 {
+		return resp, false
+	}
+	body, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+	if err != nil {
+		return resp, false
+	}
+	message := string(body)
+	return resp, strings.Contains(message, "forward REST request") || strings.Contains(message, "no known leader URL")
+}
+
+func isDialError(err error) bool {
+	var opErr *net.OpError
+	return errors.As(err, &opErr) && opErr.Op == "dial"
+}
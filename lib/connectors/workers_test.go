@@ -0,0 +1,158 @@
+//go:build !integration
+
+package connectors
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/jarcoal/httpmock.v1"
+)
+
+func refusedResponder(req *http.Request) (*http.Response, error) {
+	return nil, &net.OpError{Op: "dial", Net: "tcp", Err: syscall.ECONNREFUSED}
+}
+
+func newTestMultiWorkerClient(t *testing.T, transport *httpmock.MockTransport) MultiWorkerClient {
+	client, err := NewMultiWorkerClient(
+		[]string{"http://worker1:8083", "http://worker2:8083", "http://worker3:8083"},
+		WithTransport(transport),
+		WithRetry(0, 0, 0),
+		WithWorkerCheckInterval(0),
+	)
+	assert.NoError(t, err)
+	client.SetVersionCheck(false)
+	return client
+}
+
+func Test_MultiWorkerClient_Failover(t *testing.T) {
+	transport := httpmock.NewMockTransport()
+	transport.RegisterResponder("GET", "http://worker1:8083/connectors", refusedResponder)
+	transport.RegisterResponder("GET", "http://worker2:8083/connectors", refusedResponder)
+	transport.RegisterResponder("GET", "http://worker3:8083/connectors", httpmock.NewJsonResponderOrPanic(200, []string{"test"}))
+	client := newTestMultiWorkerClient(t, transport)
+
+	for i := 0; i < 3; i++ {
+		resp, err := client.GetAll()
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"test"}, resp.Connectors)
+	}
+
+	// workers which could not be reached are skipped once marked down
+	calls := transport.GetCallCountInfo()
+	assert.Equal(t, 1, calls["GET http://worker1:8083/connectors"])
+	assert.Equal(t, 1, calls["GET http://worker2:8083/connectors"])
+	assert.Equal(t, 3, calls["GET http://worker3:8083/connectors"])
+}
+
+func Test_MultiWorkerClient_Spread_Reads(t *testing.T) {
+	transport := httpmock.NewMockTransport()
+	for _, worker := range []string{"worker1", "worker2", "worker3"} {
+		transport.RegisterResponder("GET", "http://"+worker+":8083/connectors", httpmock.NewJsonResponderOrPanic(200, []string{}))
+	}
+	client := newTestMultiWorkerClient(t, transport)
+
+	for i := 0; i < 6; i++ {
+		_, err := client.GetAll()
+		assert.NoError(t, err)
+	}
+
+	for _, count := range transport.GetCallCountInfo() {
+		assert.Equal(t, 2, count)
+	}
+}
+
+func Test_MultiWorkerClient_Route_To_Leader(t *testing.T) {
+	transport := httpmock.NewMockTransport()
+	forwardError := httpmock.NewJsonResponderOrPanic(500, ErrorResponse{ErrorCode: 500, Message: "IO Error trying to forward REST request: java.net.ConnectException: Connection refused"})
+	transport.RegisterResponder("POST", "http://worker1:8083/connectors", forwardError)
+	transport.RegisterResponder("POST", "http://worker2:8083/connectors", httpmock.NewJsonResponderOrPanic(201, ConnectorResponse{Name: "test"}))
+	transport.RegisterResponder("PUT", "http://worker2:8083/connectors/test/pause", httpmock.NewStringResponder(202, ""))
+	client := newTestMultiWorkerClient(t, transport)
+
+	resp, err := client.CreateConnector(CreateConnectorRequest{ConnectorRequest: ConnectorRequest{Name: "test"}}, false)
+	assert.NoError(t, err)
+	assert.Equal(t, "test", resp.Name)
+
+	// next mutating request goes straight to the worker which handled the last one
+	_, err = client.PauseConnector(ConnectorRequest{Name: "test"}, false)
+	assert.NoError(t, err)
+
+	calls := transport.GetCallCountInfo()
+	assert.Equal(t, 1, calls["POST http://worker1:8083/connectors"])
+	assert.Equal(t, 1, calls["POST http://worker2:8083/connectors"])
+	assert.Equal(t, 1, calls["PUT http://worker2:8083/connectors/test/pause"])
+}
+
+func Test_MultiWorkerClient_No_Failover_When_Post_Sent(t *testing.T) {
+	transport := httpmock.NewMockTransport()
+	transport.RegisterResponder("POST", "http://worker1:8083/connectors", func(req *http.Request) (*http.Response, error) {
+		return nil, &net.OpError{Op: "read", Net: "tcp", Err: syscall.ECONNRESET}
+	})
+	client := newTestMultiWorkerClient(t, transport)
+
+	_, err := client.CreateConnector(CreateConnectorRequest{ConnectorRequest: ConnectorRequest{Name: "test"}}, false)
+
+	// the connector may have been created, it must not be sent to another worker
+	assert.Error(t, err)
+	assert.Equal(t, 1, transport.GetTotalCallCount())
+}
+
+func Test_MultiWorkerClient_CheckWorkers(t *testing.T) {
+	transport := httpmock.NewMockTransport()
+	transport.RegisterResponder("GET", "http://worker1:8083/", refusedResponder)
+	transport.RegisterResponder("GET", "http://worker2:8083/", httpmock.NewJsonResponderOrPanic(200, WorkerInfoResponse{Version: "3.6.0"}))
+	transport.RegisterResponder("GET", "http://worker3:8083/", httpmock.NewStringResponder(503, ""))
+	client := newTestMultiWorkerClient(t, transport)
+
+	result := client.CheckWorkers(context.Background())
+
+	assert.Error(t, result["http://worker1:8083"])
+	assert.NoError(t, result["http://worker2:8083"])
+	assert.Error(t, result["http://worker3:8083"])
+}
+
+func Test_MultiWorkerClient_Checks_Workers_In_Background(t *testing.T) {
+	transport := httpmock.NewMockTransport()
+	transport.RegisterResponder("GET", "http://worker1:8083/", refusedResponder)
+	for _, worker := range []string{"worker1", "worker2", "worker3"} {
+		transport.RegisterResponder("GET", "http://"+worker+":8083/connectors", httpmock.NewJsonResponderOrPanic(200, []string{}))
+	}
+	for _, worker := range []string{"worker2", "worker3"} {
+		transport.RegisterResponder("GET", "http://"+worker+":8083/", httpmock.NewJsonResponderOrPanic(200, WorkerInfoResponse{Version: "3.6.0"}))
+	}
+	client, err := NewMultiWorkerClient(
+		[]string{"http://worker1:8083", "http://worker2:8083", "http://worker3:8083"},
+		WithTransport(transport),
+		WithRetry(0, 0, 0),
+		WithWorkerCheckInterval(10*time.Millisecond),
+	)
+	assert.NoError(t, err)
+	client.SetVersionCheck(false)
+
+	// checked at once, then periodically
+	deadline := time.Now().Add(time.Second)
+	for transport.GetCallCountInfo()["GET http://worker3:8083/"] < 2 && time.Now().Before(deadline) {
+		time.Sleep(5 * time.Millisecond)
+	}
+	assert.NoError(t, client.Close())
+	checks := transport.GetCallCountInfo()["GET http://worker3:8083/"]
+	assert.True(t, checks >= 2)
+
+	for i := 0; i < 4; i++ {
+		_, err := client.GetAll()
+		assert.NoError(t, err)
+	}
+
+	// the worker which is down is skipped before a request fails on it, and checks stopped once closed
+	time.Sleep(30 * time.Millisecond)
+	calls := transport.GetCallCountInfo()
+	assert.Equal(t, 0, calls["GET http://worker1:8083/connectors"])
+	assert.Equal(t, 4, calls["GET http://worker2:8083/connectors"]+calls["GET http://worker3:8083/connectors"])
+	assert.Equal(t, checks, calls["GET http://worker3:8083/"])
+}
@@ -0,0 +1,167 @@
+//Package otelconnectors instruments clients of the connectors package with OpenTelemetry
+//It is a module of its own, so that the connectors package does not depend on OpenTelemetry
+package otelconnectors
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/ricardo-ch/go-kafka-connect/v3/lib/connectors"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const instrumentationName = "github.com/ricardo-ch/go-kafka-connect/v3/lib/otelconnectors"
+
+//Option configures an Interceptor created by NewInterceptor
+type Option func(i *Interceptor)
+
+//WithTracerProvider sets the provider of spans, the global one by default
+func WithTracerProvider(provider trace.TracerProvider) Option {
+	return func(i *Interceptor) {
+		i.tracerProvider = provider
+	}
+}
+
+//WithMeterProvider sets the provider of metrics, the global one by default
+func WithMeterProvider(provider metric.MeterProvider) Option {
+	return func(i *Interceptor) {
+		i.meterProvider = provider
+	}
+}
+
+//WithPropagator sets how the trace context is sent to kafka-connect, propagation.TraceContext by default
+func WithPropagator(propagator propagation.TextMapPropagator) Option {
+	return func(i *Interceptor) {
+		i.propagator = propagator
+	}
+}
+
+//Interceptor records spans and metrics of a client, register it with connectors.WithInterceptor or AddInterceptor
+//High-level operations such as DeployConnector get a span covering their requests and sync waits, every HTTP request
+//gets a client span, and the trace context is sent to kafka-connect in the W3C traceparent header.
+//The count, errors and latency of requests are recorded by operation, method, endpoint and status
+type Interceptor struct {
+	tracerProvider trace.TracerProvider
+	meterProvider  metric.MeterProvider
+	propagator     propagation.TextMapPropagator
+
+	tracer   trace.Tracer
+	requests metric.Int64Counter
+	errors   metric.Int64Counter
+	duration metric.Float64Histogram
+}
+
+var (
+	_ connectors.Interceptor          = &Interceptor{}
+	_ connectors.OperationInterceptor = &Interceptor{}
+)
+
+//NewInterceptor creates an Interceptor, it fails if metrics cannot be created
+func NewInterceptor(opts ...Option) (*Interceptor, error) {
+	i := &Interceptor{
+		tracerProvider: otel.GetTracerProvider(),
+		meterProvider:  otel.GetMeterProvider(),
+		propagator:     propagation.TraceContext{},
+	}
+	for _, opt := range opts {
+		opt(i)
+	}
+
+	meter := i.meterProvider.Meter(instrumentationName)
+	var err error
+	i.requests, err = meter.Int64Counter("kafka_connect.client.requests",
+		metric.WithDescription("Number of requests sent to kafka-connect"))
+	if err != nil {
+		return nil, err
+	}
+	i.errors, err = meter.Int64Counter("kafka_connect.client.errors",
+		metric.WithDescription("Number of requests to kafka-connect which failed or were answered with an error status"))
+	if err != nil {
+		return nil, err
+	}
+	i.duration, err = meter.Float64Histogram("kafka_connect.client.request.duration",
+		metric.WithDescription("Latency of requests sent to kafka-connect"),
+		metric.WithUnit("s"))
+	if err != nil {
+		return nil, err
+	}
+	i.tracer = i.tracerProvider.Tracer(instrumentationName)
+	return i, nil
+}
+
+//StartOperation starts the span of a client operation, which is a child of the span of ctx if any
+func (i *Interceptor) StartOperation(ctx context.Context, operation string, connector string) (context.Context, func(err error)) {
+	attributes := []attribute.KeyValue{attribute.String("kafka_connect.operation", operation)}
+	if connector != "" {
+		attributes = append(attributes, attribute.String("kafka_connect.connector", connector))
+	}
+	ctx, span := i.tracer.Start(ctx, operation, trace.WithAttributes(attributes...))
+	return ctx, func(err error) {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}
+}
+
+//BeforeRequest starts the span of an HTTP request, and sends its context along
+func (i *Interceptor) BeforeRequest(req *connectors.RequestInfo) error {
+	attributes := []attribute.KeyValue{
+		attribute.String("http.request.method", req.Method()),
+		attribute.String("url.full", req.URL()),
+		attribute.String("kafka_connect.operation", req.Operation),
+	}
+	if req.Connector != "" {
+		attributes = append(attributes, attribute.String("kafka_connect.connector", req.Connector))
+	}
+	if req.Attempt > 1 {
+		attributes = append(attributes, attribute.Int("http.resend_count", req.Attempt-1))
+	}
+	ctx, _ := i.tracer.Start(req.Request.Context(), req.Method()+" "+req.Endpoint,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(attributes...))
+
+	i.propagator.Inject(ctx, propagation.HeaderCarrier(req.Request.Header))
+	req.Request = req.Request.WithContext(ctx)
+	return nil
+}
+
+//AfterResponse ends the span of an HTTP request, and records its metrics
+func (i *Interceptor) AfterResponse(resp *connectors.ResponseInfo) {
+	ctx := resp.Request.Context()
+	span := trace.SpanFromContext(ctx)
+	status := resp.StatusCode()
+
+	attributes := []attribute.KeyValue{
+		attribute.String("kafka_connect.operation", resp.Operation),
+		attribute.String("http.request.method", resp.Method()),
+		attribute.String("kafka_connect.endpoint", resp.Endpoint),
+	}
+	if status != 0 {
+		attributes = append(attributes, attribute.Int("http.response.status_code", status))
+		span.SetAttributes(attribute.Int("http.response.status_code", status))
+	}
+
+	failed := resp.Err != nil || status >= 400
+	switch {
+	case resp.Err != nil:
+		span.RecordError(resp.Err)
+		span.SetStatus(codes.Error, resp.Err.Error())
+	case status >= 400:
+		span.SetStatus(codes.Error, strconv.Itoa(status))
+	}
+	span.End()
+
+	options := metric.WithAttributes(attributes...)
+	i.requests.Add(ctx, 1, options)
+	if failed {
+		i.errors.Add(ctx, 1, options)
+	}
+	i.duration.Record(ctx, resp.Latency.Seconds(), options)
+}
@@ -0,0 +1,142 @@
+//go:build !integration
+
+package otelconnectors
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/ricardo-ch/go-kafka-connect/v3/lib/connectors"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/attribute"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"gopkg.in/jarcoal/httpmock.v1"
+)
+
+func Test_Telemetry_DeployConnector(t *testing.T) {
+	spans := tracetest.NewInMemoryExporter()
+	tracerProvider := sdktrace.NewTracerProvider(sdktrace.WithSyncer(spans))
+	reader := sdkmetric.NewManualReader()
+	meterProvider := sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))
+
+	transport := httpmock.NewMockTransport()
+	deployedConfig := map[string]string{"name": "test", "key": "old"}
+	transport.RegisterResponder("GET", "http://randomurl/connectors/test", httpmock.NewJsonResponderOrPanic(200, connectors.ConnectorResponse{Name: "test"}))
+	transport.RegisterResponder("GET", "http://randomurl/connectors/test/config", func(req *http.Request) (*http.Response, error) {
+		assert.Regexp(t, "^00-[0-9a-f]{32}-[0-9a-f]{16}-01$", req.Header.Get("traceparent"))
+		return httpmock.NewJsonResponse(200, deployedConfig)
+	})
+	transport.RegisterResponder("PUT", "http://randomurl/connectors/test/config", func(req *http.Request) (*http.Response, error) {
+		deployedConfig = map[string]string{"name": "test", "key": "new"}
+		return httpmock.NewJsonResponse(200, connectors.ConnectorResponse{Name: "test"})
+	})
+
+	interceptor, err := NewInterceptor(WithTracerProvider(tracerProvider), WithMeterProvider(meterProvider))
+	assert.NoError(t, err)
+	client, err := connectors.NewClientWithOptions("http://randomurl",
+		connectors.WithTransport(transport),
+		connectors.WithInterceptor(interceptor),
+	)
+	assert.NoError(t, err)
+
+	err = client.DeployConnector(connectors.CreateConnectorRequest{
+		ConnectorRequest: connectors.ConnectorRequest{Name: "test"},
+		Config:           map[string]interface{}{"key": "new"},
+	})
+	assert.NoError(t, err)
+
+	// every span is a descendant of DeployConnector
+	assert.Len(t, spans.GetSpans(), 8)
+	byID := map[string]tracetest.SpanStub{}
+	var root tracetest.SpanStub
+	for _, span := range spans.GetSpans() {
+		byID[span.SpanContext.SpanID().String()] = span
+		if span.Name == "DeployConnector" {
+			root = span
+		}
+	}
+	path := func(span tracetest.SpanStub) []string {
+		var names []string
+		for span.Parent.IsValid() {
+			span = byID[span.Parent.SpanID().String()]
+			names = append([]string{span.Name}, names...)
+		}
+		return names
+	}
+	assert.Equal(t, "test", attributeValue(root.Attributes, "kafka_connect.connector"))
+	for _, span := range spans.GetSpans() {
+		switch span.Name {
+		case "DeployConnector":
+			assert.Empty(t, path(span))
+		case "GET connectors/{name}", "IsUpToDate", "UpdateConnector":
+			assert.Equal(t, []string{"DeployConnector"}, path(span))
+		case "PUT connectors/{name}/config", "wait updating connector":
+			assert.Equal(t, []string{"DeployConnector", "UpdateConnector"}, path(span))
+		case "GET connectors/{name}/config":
+			assert.Contains(t, [][]string{
+				{"DeployConnector", "IsUpToDate"},
+				{"DeployConnector", "UpdateConnector", "wait updating connector"},
+			}, path(span))
+		default:
+			t.Errorf("unexpected span %v", span.Name)
+		}
+	}
+
+	var metrics metricdata.ResourceMetrics
+	assert.NoError(t, reader.Collect(context.Background(), &metrics))
+	requests := map[string]int64{}
+	for _, scope := range metrics.ScopeMetrics {
+		for _, m := range scope.Metrics {
+			if m.Name != "kafka_connect.client.requests" {
+				continue
+			}
+			for _, point := range m.Data.(metricdata.Sum[int64]).DataPoints {
+				endpoint, _ := point.Attributes.Value("kafka_connect.endpoint")
+				requests[endpoint.AsString()] += point.Value
+			}
+		}
+	}
+	assert.Equal(t, map[string]int64{
+		"connectors/{name}":        1,
+		"connectors/{name}/config": 3,
+	}, requests)
+}
+
+func Test_Telemetry_Through_ChainInterceptors(t *testing.T) {
+	spans := tracetest.NewInMemoryExporter()
+	tracerProvider := sdktrace.NewTracerProvider(sdktrace.WithSyncer(spans))
+
+	transport := httpmock.NewMockTransport()
+	transport.RegisterResponder("PUT", "http://randomurl/connectors/test/pause", httpmock.NewStringResponder(202, ""))
+
+	interceptor, err := NewInterceptor(WithTracerProvider(tracerProvider))
+	assert.NoError(t, err)
+	client, err := connectors.NewClientWithOptions("http://randomurl",
+		connectors.WithTransport(transport),
+		connectors.WithInterceptor(connectors.ChainInterceptors(connectors.InterceptorFuncs{}, interceptor)),
+	)
+	assert.NoError(t, err)
+
+	_, err = client.PauseConnector(connectors.ConnectorRequest{Name: "test"}, false)
+	assert.NoError(t, err)
+
+	if assert.Len(t, spans.GetSpans(), 2) {
+		request, operation := spans.GetSpans()[0], spans.GetSpans()[1]
+		assert.Equal(t, "PUT connectors/{name}/pause", request.Name)
+		assert.Equal(t, "PauseConnector", operation.Name)
+		assert.Equal(t, operation.SpanContext.SpanID(), request.Parent.SpanID())
+	}
+}
+
+func attributeValue(attributes []attribute.KeyValue, key attribute.Key) string {
+	for _, kv := range attributes {
+		if kv.Key == key {
+			return kv.Value.AsString()
+		}
+	}
+	return ""
+}